@@ -0,0 +1,107 @@
+package authz
+
+import "github.com/golang-jwt/jwt/v5"
+
+// Claims are the claims of the JWTs identifying callers: the registered claims and the roles of the caller.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles,omitempty"`
+}
+
+// Roles of the default policy, from the least to the most privileged.
+const (
+	RoleViewer = "viewer"
+	RoleEditor = "editor"
+	RoleAdmin  = "admin"
+)
+
+// Rule lists the roles allowed to call an RPC, such as viewer, editor or admin.
+// The RPC is named by its service and method, such as SongApi/DeleteSong.
+type Rule struct {
+	RPC   string
+	Roles []string
+}
+
+// Policy holds the roles allowed to call each RPC, by service and method name such as SongApi/DeleteSong.
+type Policy map[string][]string
+
+// DefaultPolicy returns the policy of every RPC of SongApi and PlaylistApi.
+// Viewers may read songs and playlists, editors may also change them and admins may also delete them.
+func DefaultPolicy() Policy {
+	viewers := []string{RoleViewer, RoleEditor, RoleAdmin}
+	editors := []string{RoleEditor, RoleAdmin}
+	admins := []string{RoleAdmin}
+	return Policy{
+		"SongApi/GetSong":         viewers,
+		"SongApi/ListSongs":       viewers,
+		"SongApi/SearchSongs":     viewers,
+		"SongApi/ListSongHistory": viewers,
+		"SongApi/WatchSongs":      viewers,
+		"SongApi/ExportSongs":     viewers,
+		"SongApi/ExportM3U":       viewers,
+		"SongApi/ExportXSPF":      viewers,
+		"SongApi/CheckDuplicates": viewers,
+
+		"SongApi/CreateSong":       editors,
+		"SongApi/UpdateSong":       editors,
+		"SongApi/RevertSong":       editors,
+		"SongApi/ImportSongs":      editors,
+		"SongApi/ImportM3U":        editors,
+		"SongApi/ImportXSPF":       editors,
+		"SongApi/ImportRows":       editors,
+		"SongApi/ListDeletedSongs": editors,
+
+		"SongApi/DeleteSong":  admins,
+		"SongApi/RestoreSong": admins,
+		"SongApi/PurgeSong":   admins,
+
+		"PlaylistApi/GetPlaylist":   viewers,
+		"PlaylistApi/ListPlaylists": viewers,
+		"PlaylistApi/ExportM3U":     viewers,
+		"PlaylistApi/ExportXSPF":    viewers,
+
+		"PlaylistApi/CreatePlaylist": editors,
+		"PlaylistApi/RenamePlaylist": editors,
+		"PlaylistApi/AddTrack":       editors,
+		"PlaylistApi/RemoveTrack":    editors,
+		"PlaylistApi/MoveTrack":      editors,
+
+		"PlaylistApi/DeletePlaylist": admins,
+	}
+}
+
+// NewPolicy creates a new Policy from the rules of a configuration.
+// Rules naming the same RPC add up.
+func NewPolicy(rules []Rule) Policy {
+	policy := make(Policy)
+	for _, rule := range rules {
+		policy[rule.RPC] = append(policy[rule.RPC], rule.Roles...)
+	}
+	return policy
+}
+
+// Override returns a copy of the policy where the RPCs named by the rules are allowed to the roles of the rules only.
+// It is used to adjust the DefaultPolicy with the rules of a configuration.
+func (p Policy) Override(rules []Rule) Policy {
+	policy := make(Policy, len(p))
+	for rpc, roles := range p {
+		policy[rpc] = roles
+	}
+	for rpc, roles := range NewPolicy(rules) {
+		policy[rpc] = roles
+	}
+	return policy
+}
+
+// Allows reports whether a caller holding roles may call the RPC.
+// RPCs missing from the policy are denied to everyone.
+func (p Policy) Allows(rpc string, roles []string) bool {
+	for _, allowed := range p[rpc] {
+		for _, role := range roles {
+			if role == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
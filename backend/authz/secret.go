@@ -0,0 +1,38 @@
+package authz
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// placeholderPrefix starts the placeholder values of secrets that must be replaced before running, such as "change-this-secret".
+const placeholderPrefix = "change-this"
+
+// CheckSecret returns an error when a configured secret, password or key is empty or still a placeholder.
+// It takes the name of the setting, used in the error message, and its value as input.
+func CheckSecret(name, value string) error {
+	if strings.TrimSpace(value) == "" {
+		return fmt.Errorf("%s is empty", name)
+	}
+	if strings.HasPrefix(strings.ToLower(value), placeholderPrefix) {
+		return fmt.Errorf("%s is still the placeholder %q, set a secret value", name, value)
+	}
+	return nil
+}
+
+// SecretFromEnv returns the secret of name held by the environment variable prefix followed by the upper-cased name,
+// such as MUSICPLAYLIST_API_KEY_SCRIPTS for the prefix MUSICPLAYLIST_API_KEY_ and the name scripts.
+// Characters not allowed in variable names are replaced by underscores.
+func SecretFromEnv(prefix, name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, name)
+	return os.Getenv(prefix + name)
+}
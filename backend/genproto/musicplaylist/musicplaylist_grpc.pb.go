@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -21,10 +20,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	SongApi_CreateSong_FullMethodName = "/protoapi.SongApi/CreateSong"
-	SongApi_ListSongs_FullMethodName  = "/protoapi.SongApi/ListSongs"
-	SongApi_UpdateSong_FullMethodName = "/protoapi.SongApi/UpdateSong"
-	SongApi_DeleteSong_FullMethodName = "/protoapi.SongApi/DeleteSong"
+	SongApi_CreateSong_FullMethodName       = "/protoapi.SongApi/CreateSong"
+	SongApi_GetSong_FullMethodName          = "/protoapi.SongApi/GetSong"
+	SongApi_ListSongs_FullMethodName        = "/protoapi.SongApi/ListSongs"
+	SongApi_SearchSongs_FullMethodName      = "/protoapi.SongApi/SearchSongs"
+	SongApi_UpdateSong_FullMethodName       = "/protoapi.SongApi/UpdateSong"
+	SongApi_DeleteSong_FullMethodName       = "/protoapi.SongApi/DeleteSong"
+	SongApi_ListDeletedSongs_FullMethodName = "/protoapi.SongApi/ListDeletedSongs"
+	SongApi_RestoreSong_FullMethodName      = "/protoapi.SongApi/RestoreSong"
+	SongApi_PurgeSong_FullMethodName        = "/protoapi.SongApi/PurgeSong"
+	SongApi_ListSongHistory_FullMethodName  = "/protoapi.SongApi/ListSongHistory"
+	SongApi_RevertSong_FullMethodName       = "/protoapi.SongApi/RevertSong"
+	SongApi_WatchSongs_FullMethodName       = "/protoapi.SongApi/WatchSongs"
+	SongApi_ImportSongs_FullMethodName      = "/protoapi.SongApi/ImportSongs"
+	SongApi_ExportSongs_FullMethodName      = "/protoapi.SongApi/ExportSongs"
+	SongApi_ExportM3U_FullMethodName        = "/protoapi.SongApi/ExportM3U"
+	SongApi_ImportM3U_FullMethodName        = "/protoapi.SongApi/ImportM3U"
+	SongApi_ExportXSPF_FullMethodName       = "/protoapi.SongApi/ExportXSPF"
+	SongApi_ImportXSPF_FullMethodName       = "/protoapi.SongApi/ImportXSPF"
+	SongApi_CheckDuplicates_FullMethodName  = "/protoapi.SongApi/CheckDuplicates"
+	SongApi_ImportRows_FullMethodName       = "/protoapi.SongApi/ImportRows"
 )
 
 // SongApiClient is the client API for SongApi service.
@@ -32,9 +47,26 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SongApiClient interface {
 	CreateSong(ctx context.Context, in *Song, opts ...grpc.CallOption) (*Song, error)
-	ListSongs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SongList, error)
-	UpdateSong(ctx context.Context, in *Song, opts ...grpc.CallOption) (*Song, error)
-	DeleteSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	GetSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Song, error)
+	ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*SongList, error)
+	SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*SongList, error)
+	UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*Song, error)
+	DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	ListDeletedSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*SongList, error)
+	RestoreSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Song, error)
+	PurgeSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	ListSongHistory(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*SongHistory, error)
+	RevertSong(ctx context.Context, in *RevertSongRequest, opts ...grpc.CallOption) (*Song, error)
+	WatchSongs(ctx context.Context, in *WatchSongsRequest, opts ...grpc.CallOption) (SongApi_WatchSongsClient, error)
+	// send the x-dry-run: true metadata to only validate the songs
+	ImportSongs(ctx context.Context, opts ...grpc.CallOption) (SongApi_ImportSongsClient, error)
+	ExportSongs(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongApi_ExportSongsClient, error)
+	ExportM3U(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongApi_ExportM3UClient, error)
+	ImportM3U(ctx context.Context, in *ImportFileRequest, opts ...grpc.CallOption) (*ImportSummary, error)
+	ExportXSPF(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongApi_ExportXSPFClient, error)
+	ImportXSPF(ctx context.Context, in *ImportFileRequest, opts ...grpc.CallOption) (*ImportSummary, error)
+	CheckDuplicates(ctx context.Context, in *CheckDuplicatesRequest, opts ...grpc.CallOption) (*DuplicateReport, error)
+	ImportRows(ctx context.Context, in *ImportRowsRequest, opts ...grpc.CallOption) (*ImportSummary, error)
 }
 
 type songApiClient struct {
@@ -54,7 +86,16 @@ func (c *songApiClient) CreateSong(ctx context.Context, in *Song, opts ...grpc.C
 	return out, nil
 }
 
-func (c *songApiClient) ListSongs(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SongList, error) {
+func (c *songApiClient) GetSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongApi_GetSong_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) ListSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*SongList, error) {
 	out := new(SongList)
 	err := c.cc.Invoke(ctx, SongApi_ListSongs_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -63,7 +104,16 @@ func (c *songApiClient) ListSongs(ctx context.Context, in *emptypb.Empty, opts .
 	return out, nil
 }
 
-func (c *songApiClient) UpdateSong(ctx context.Context, in *Song, opts ...grpc.CallOption) (*Song, error) {
+func (c *songApiClient) SearchSongs(ctx context.Context, in *SearchSongsRequest, opts ...grpc.CallOption) (*SongList, error) {
+	out := new(SongList)
+	err := c.cc.Invoke(ctx, SongApi_SearchSongs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) UpdateSong(ctx context.Context, in *UpdateSongRequest, opts ...grpc.CallOption) (*Song, error) {
 	out := new(Song)
 	err := c.cc.Invoke(ctx, SongApi_UpdateSong_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -72,7 +122,7 @@ func (c *songApiClient) UpdateSong(ctx context.Context, in *Song, opts ...grpc.C
 	return out, nil
 }
 
-func (c *songApiClient) DeleteSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+func (c *songApiClient) DeleteSong(ctx context.Context, in *DeleteSongRequest, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
 	out := new(wrapperspb.BoolValue)
 	err := c.cc.Invoke(ctx, SongApi_DeleteSong_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -81,14 +131,274 @@ func (c *songApiClient) DeleteSong(ctx context.Context, in *wrapperspb.StringVal
 	return out, nil
 }
 
+func (c *songApiClient) ListDeletedSongs(ctx context.Context, in *ListSongsRequest, opts ...grpc.CallOption) (*SongList, error) {
+	out := new(SongList)
+	err := c.cc.Invoke(ctx, SongApi_ListDeletedSongs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) RestoreSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongApi_RestoreSong_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) PurgeSong(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, SongApi_PurgeSong_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) ListSongHistory(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*SongHistory, error) {
+	out := new(SongHistory)
+	err := c.cc.Invoke(ctx, SongApi_ListSongHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) RevertSong(ctx context.Context, in *RevertSongRequest, opts ...grpc.CallOption) (*Song, error) {
+	out := new(Song)
+	err := c.cc.Invoke(ctx, SongApi_RevertSong_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) WatchSongs(ctx context.Context, in *WatchSongsRequest, opts ...grpc.CallOption) (SongApi_WatchSongsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongApi_ServiceDesc.Streams[0], SongApi_WatchSongs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songApiWatchSongsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SongApi_WatchSongsClient interface {
+	Recv() (*SongEvent, error)
+	grpc.ClientStream
+}
+
+type songApiWatchSongsClient struct {
+	grpc.ClientStream
+}
+
+func (x *songApiWatchSongsClient) Recv() (*SongEvent, error) {
+	m := new(SongEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *songApiClient) ImportSongs(ctx context.Context, opts ...grpc.CallOption) (SongApi_ImportSongsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongApi_ServiceDesc.Streams[1], SongApi_ImportSongs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songApiImportSongsClient{stream}
+	return x, nil
+}
+
+type SongApi_ImportSongsClient interface {
+	Send(*Song) error
+	CloseAndRecv() (*ImportSummary, error)
+	grpc.ClientStream
+}
+
+type songApiImportSongsClient struct {
+	grpc.ClientStream
+}
+
+func (x *songApiImportSongsClient) Send(m *Song) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *songApiImportSongsClient) CloseAndRecv() (*ImportSummary, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportSummary)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *songApiClient) ExportSongs(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongApi_ExportSongsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongApi_ServiceDesc.Streams[2], SongApi_ExportSongs_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songApiExportSongsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SongApi_ExportSongsClient interface {
+	Recv() (*Song, error)
+	grpc.ClientStream
+}
+
+type songApiExportSongsClient struct {
+	grpc.ClientStream
+}
+
+func (x *songApiExportSongsClient) Recv() (*Song, error) {
+	m := new(Song)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *songApiClient) ExportM3U(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongApi_ExportM3UClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongApi_ServiceDesc.Streams[3], SongApi_ExportM3U_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songApiExportM3UClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SongApi_ExportM3UClient interface {
+	Recv() (*PlaylistFile, error)
+	grpc.ClientStream
+}
+
+type songApiExportM3UClient struct {
+	grpc.ClientStream
+}
+
+func (x *songApiExportM3UClient) Recv() (*PlaylistFile, error) {
+	m := new(PlaylistFile)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *songApiClient) ImportM3U(ctx context.Context, in *ImportFileRequest, opts ...grpc.CallOption) (*ImportSummary, error) {
+	out := new(ImportSummary)
+	err := c.cc.Invoke(ctx, SongApi_ImportM3U_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) ExportXSPF(ctx context.Context, in *ExportSongsRequest, opts ...grpc.CallOption) (SongApi_ExportXSPFClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SongApi_ServiceDesc.Streams[4], SongApi_ExportXSPF_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &songApiExportXSPFClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SongApi_ExportXSPFClient interface {
+	Recv() (*PlaylistFile, error)
+	grpc.ClientStream
+}
+
+type songApiExportXSPFClient struct {
+	grpc.ClientStream
+}
+
+func (x *songApiExportXSPFClient) Recv() (*PlaylistFile, error) {
+	m := new(PlaylistFile)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *songApiClient) ImportXSPF(ctx context.Context, in *ImportFileRequest, opts ...grpc.CallOption) (*ImportSummary, error) {
+	out := new(ImportSummary)
+	err := c.cc.Invoke(ctx, SongApi_ImportXSPF_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) CheckDuplicates(ctx context.Context, in *CheckDuplicatesRequest, opts ...grpc.CallOption) (*DuplicateReport, error) {
+	out := new(DuplicateReport)
+	err := c.cc.Invoke(ctx, SongApi_CheckDuplicates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *songApiClient) ImportRows(ctx context.Context, in *ImportRowsRequest, opts ...grpc.CallOption) (*ImportSummary, error) {
+	out := new(ImportSummary)
+	err := c.cc.Invoke(ctx, SongApi_ImportRows_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SongApiServer is the server API for SongApi service.
 // All implementations must embed UnimplementedSongApiServer
 // for forward compatibility
 type SongApiServer interface {
 	CreateSong(context.Context, *Song) (*Song, error)
-	ListSongs(context.Context, *emptypb.Empty) (*SongList, error)
-	UpdateSong(context.Context, *Song) (*Song, error)
-	DeleteSong(context.Context, *wrapperspb.StringValue) (*wrapperspb.BoolValue, error)
+	GetSong(context.Context, *wrapperspb.StringValue) (*Song, error)
+	ListSongs(context.Context, *ListSongsRequest) (*SongList, error)
+	SearchSongs(context.Context, *SearchSongsRequest) (*SongList, error)
+	UpdateSong(context.Context, *UpdateSongRequest) (*Song, error)
+	DeleteSong(context.Context, *DeleteSongRequest) (*wrapperspb.BoolValue, error)
+	ListDeletedSongs(context.Context, *ListSongsRequest) (*SongList, error)
+	RestoreSong(context.Context, *wrapperspb.StringValue) (*Song, error)
+	PurgeSong(context.Context, *wrapperspb.StringValue) (*wrapperspb.BoolValue, error)
+	ListSongHistory(context.Context, *wrapperspb.StringValue) (*SongHistory, error)
+	RevertSong(context.Context, *RevertSongRequest) (*Song, error)
+	WatchSongs(*WatchSongsRequest, SongApi_WatchSongsServer) error
+	// send the x-dry-run: true metadata to only validate the songs
+	ImportSongs(SongApi_ImportSongsServer) error
+	ExportSongs(*ExportSongsRequest, SongApi_ExportSongsServer) error
+	ExportM3U(*ExportSongsRequest, SongApi_ExportM3UServer) error
+	ImportM3U(context.Context, *ImportFileRequest) (*ImportSummary, error)
+	ExportXSPF(*ExportSongsRequest, SongApi_ExportXSPFServer) error
+	ImportXSPF(context.Context, *ImportFileRequest) (*ImportSummary, error)
+	CheckDuplicates(context.Context, *CheckDuplicatesRequest) (*DuplicateReport, error)
+	ImportRows(context.Context, *ImportRowsRequest) (*ImportSummary, error)
 	mustEmbedUnimplementedSongApiServer()
 }
 
@@ -99,15 +409,63 @@ type UnimplementedSongApiServer struct {
 func (UnimplementedSongApiServer) CreateSong(context.Context, *Song) (*Song, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSong not implemented")
 }
-func (UnimplementedSongApiServer) ListSongs(context.Context, *emptypb.Empty) (*SongList, error) {
+func (UnimplementedSongApiServer) GetSong(context.Context, *wrapperspb.StringValue) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSong not implemented")
+}
+func (UnimplementedSongApiServer) ListSongs(context.Context, *ListSongsRequest) (*SongList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSongs not implemented")
 }
-func (UnimplementedSongApiServer) UpdateSong(context.Context, *Song) (*Song, error) {
+func (UnimplementedSongApiServer) SearchSongs(context.Context, *SearchSongsRequest) (*SongList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchSongs not implemented")
+}
+func (UnimplementedSongApiServer) UpdateSong(context.Context, *UpdateSongRequest) (*Song, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSong not implemented")
 }
-func (UnimplementedSongApiServer) DeleteSong(context.Context, *wrapperspb.StringValue) (*wrapperspb.BoolValue, error) {
+func (UnimplementedSongApiServer) DeleteSong(context.Context, *DeleteSongRequest) (*wrapperspb.BoolValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSong not implemented")
 }
+func (UnimplementedSongApiServer) ListDeletedSongs(context.Context, *ListSongsRequest) (*SongList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedSongs not implemented")
+}
+func (UnimplementedSongApiServer) RestoreSong(context.Context, *wrapperspb.StringValue) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSong not implemented")
+}
+func (UnimplementedSongApiServer) PurgeSong(context.Context, *wrapperspb.StringValue) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeSong not implemented")
+}
+func (UnimplementedSongApiServer) ListSongHistory(context.Context, *wrapperspb.StringValue) (*SongHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSongHistory not implemented")
+}
+func (UnimplementedSongApiServer) RevertSong(context.Context, *RevertSongRequest) (*Song, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertSong not implemented")
+}
+func (UnimplementedSongApiServer) WatchSongs(*WatchSongsRequest, SongApi_WatchSongsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchSongs not implemented")
+}
+func (UnimplementedSongApiServer) ImportSongs(SongApi_ImportSongsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportSongs not implemented")
+}
+func (UnimplementedSongApiServer) ExportSongs(*ExportSongsRequest, SongApi_ExportSongsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportSongs not implemented")
+}
+func (UnimplementedSongApiServer) ExportM3U(*ExportSongsRequest, SongApi_ExportM3UServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportM3U not implemented")
+}
+func (UnimplementedSongApiServer) ImportM3U(context.Context, *ImportFileRequest) (*ImportSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportM3U not implemented")
+}
+func (UnimplementedSongApiServer) ExportXSPF(*ExportSongsRequest, SongApi_ExportXSPFServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportXSPF not implemented")
+}
+func (UnimplementedSongApiServer) ImportXSPF(context.Context, *ImportFileRequest) (*ImportSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportXSPF not implemented")
+}
+func (UnimplementedSongApiServer) CheckDuplicates(context.Context, *CheckDuplicatesRequest) (*DuplicateReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDuplicates not implemented")
+}
+func (UnimplementedSongApiServer) ImportRows(context.Context, *ImportRowsRequest) (*ImportSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRows not implemented")
+}
 func (UnimplementedSongApiServer) mustEmbedUnimplementedSongApiServer() {}
 
 // UnsafeSongApiServer may be embedded to opt out of forward compatibility for this service.
@@ -139,8 +497,26 @@ func _SongApi_CreateSong_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SongApi_GetSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).GetSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_GetSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).GetSong(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SongApi_ListSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListSongsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -152,13 +528,31 @@ func _SongApi_ListSongs_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: SongApi_ListSongs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SongApiServer).ListSongs(ctx, req.(*emptypb.Empty))
+		return srv.(SongApiServer).ListSongs(ctx, req.(*ListSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_SearchSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).SearchSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_SearchSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).SearchSongs(ctx, req.(*SearchSongsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _SongApi_UpdateSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Song)
+	in := new(UpdateSongRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -170,13 +564,13 @@ func _SongApi_UpdateSong_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: SongApi_UpdateSong_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SongApiServer).UpdateSong(ctx, req.(*Song))
+		return srv.(SongApiServer).UpdateSong(ctx, req.(*UpdateSongRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _SongApi_DeleteSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(wrapperspb.StringValue)
+	in := new(DeleteSongRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -188,35 +582,855 @@ func _SongApi_DeleteSong_Handler(srv interface{}, ctx context.Context, dec func(
 		FullMethod: SongApi_DeleteSong_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SongApiServer).DeleteSong(ctx, req.(*wrapperspb.StringValue))
+		return srv.(SongApiServer).DeleteSong(ctx, req.(*DeleteSongRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// SongApi_ServiceDesc is the grpc.ServiceDesc for SongApi service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var SongApi_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "protoapi.SongApi",
-	HandlerType: (*SongApiServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateSong",
-			Handler:    _SongApi_CreateSong_Handler,
-		},
-		{
-			MethodName: "ListSongs",
-			Handler:    _SongApi_ListSongs_Handler,
-		},
-		{
-			MethodName: "UpdateSong",
-			Handler:    _SongApi_UpdateSong_Handler,
-		},
-		{
-			MethodName: "DeleteSong",
-			Handler:    _SongApi_DeleteSong_Handler,
+func _SongApi_ListDeletedSongs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSongsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).ListDeletedSongs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_ListDeletedSongs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).ListDeletedSongs(ctx, req.(*ListSongsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_RestoreSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).RestoreSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_RestoreSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).RestoreSong(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_PurgeSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).PurgeSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_PurgeSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).PurgeSong(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_ListSongHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).ListSongHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_ListSongHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).ListSongHistory(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_RevertSong_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertSongRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).RevertSong(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_RevertSong_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).RevertSong(ctx, req.(*RevertSongRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_WatchSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongApiServer).WatchSongs(m, &songApiWatchSongsServer{stream})
+}
+
+type SongApi_WatchSongsServer interface {
+	Send(*SongEvent) error
+	grpc.ServerStream
+}
+
+type songApiWatchSongsServer struct {
+	grpc.ServerStream
+}
+
+func (x *songApiWatchSongsServer) Send(m *SongEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SongApi_ImportSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SongApiServer).ImportSongs(&songApiImportSongsServer{stream})
+}
+
+type SongApi_ImportSongsServer interface {
+	SendAndClose(*ImportSummary) error
+	Recv() (*Song, error)
+	grpc.ServerStream
+}
+
+type songApiImportSongsServer struct {
+	grpc.ServerStream
+}
+
+func (x *songApiImportSongsServer) SendAndClose(m *ImportSummary) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *songApiImportSongsServer) Recv() (*Song, error) {
+	m := new(Song)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _SongApi_ExportSongs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongApiServer).ExportSongs(m, &songApiExportSongsServer{stream})
+}
+
+type SongApi_ExportSongsServer interface {
+	Send(*Song) error
+	grpc.ServerStream
+}
+
+type songApiExportSongsServer struct {
+	grpc.ServerStream
+}
+
+func (x *songApiExportSongsServer) Send(m *Song) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SongApi_ExportM3U_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongApiServer).ExportM3U(m, &songApiExportM3UServer{stream})
+}
+
+type SongApi_ExportM3UServer interface {
+	Send(*PlaylistFile) error
+	grpc.ServerStream
+}
+
+type songApiExportM3UServer struct {
+	grpc.ServerStream
+}
+
+func (x *songApiExportM3UServer) Send(m *PlaylistFile) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SongApi_ImportM3U_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).ImportM3U(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_ImportM3U_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).ImportM3U(ctx, req.(*ImportFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_ExportXSPF_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportSongsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SongApiServer).ExportXSPF(m, &songApiExportXSPFServer{stream})
+}
+
+type SongApi_ExportXSPFServer interface {
+	Send(*PlaylistFile) error
+	grpc.ServerStream
+}
+
+type songApiExportXSPFServer struct {
+	grpc.ServerStream
+}
+
+func (x *songApiExportXSPFServer) Send(m *PlaylistFile) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SongApi_ImportXSPF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).ImportXSPF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_ImportXSPF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).ImportXSPF(ctx, req.(*ImportFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_CheckDuplicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDuplicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).CheckDuplicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_CheckDuplicates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).CheckDuplicates(ctx, req.(*CheckDuplicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SongApi_ImportRows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SongApiServer).ImportRows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SongApi_ImportRows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SongApiServer).ImportRows(ctx, req.(*ImportRowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SongApi_ServiceDesc is the grpc.ServiceDesc for SongApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SongApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protoapi.SongApi",
+	HandlerType: (*SongApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSong",
+			Handler:    _SongApi_CreateSong_Handler,
+		},
+		{
+			MethodName: "GetSong",
+			Handler:    _SongApi_GetSong_Handler,
+		},
+		{
+			MethodName: "ListSongs",
+			Handler:    _SongApi_ListSongs_Handler,
+		},
+		{
+			MethodName: "SearchSongs",
+			Handler:    _SongApi_SearchSongs_Handler,
+		},
+		{
+			MethodName: "UpdateSong",
+			Handler:    _SongApi_UpdateSong_Handler,
+		},
+		{
+			MethodName: "DeleteSong",
+			Handler:    _SongApi_DeleteSong_Handler,
+		},
+		{
+			MethodName: "ListDeletedSongs",
+			Handler:    _SongApi_ListDeletedSongs_Handler,
+		},
+		{
+			MethodName: "RestoreSong",
+			Handler:    _SongApi_RestoreSong_Handler,
+		},
+		{
+			MethodName: "PurgeSong",
+			Handler:    _SongApi_PurgeSong_Handler,
+		},
+		{
+			MethodName: "ListSongHistory",
+			Handler:    _SongApi_ListSongHistory_Handler,
+		},
+		{
+			MethodName: "RevertSong",
+			Handler:    _SongApi_RevertSong_Handler,
+		},
+		{
+			MethodName: "ImportM3U",
+			Handler:    _SongApi_ImportM3U_Handler,
+		},
+		{
+			MethodName: "ImportXSPF",
+			Handler:    _SongApi_ImportXSPF_Handler,
+		},
+		{
+			MethodName: "CheckDuplicates",
+			Handler:    _SongApi_CheckDuplicates_Handler,
+		},
+		{
+			MethodName: "ImportRows",
+			Handler:    _SongApi_ImportRows_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSongs",
+			Handler:       _SongApi_WatchSongs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportSongs",
+			Handler:       _SongApi_ImportSongs_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportSongs",
+			Handler:       _SongApi_ExportSongs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportM3U",
+			Handler:       _SongApi_ExportM3U_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportXSPF",
+			Handler:       _SongApi_ExportXSPF_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "musicplaylist.proto",
+}
+
+const (
+	PlaylistApi_CreatePlaylist_FullMethodName = "/protoapi.PlaylistApi/CreatePlaylist"
+	PlaylistApi_GetPlaylist_FullMethodName    = "/protoapi.PlaylistApi/GetPlaylist"
+	PlaylistApi_ListPlaylists_FullMethodName  = "/protoapi.PlaylistApi/ListPlaylists"
+	PlaylistApi_RenamePlaylist_FullMethodName = "/protoapi.PlaylistApi/RenamePlaylist"
+	PlaylistApi_DeletePlaylist_FullMethodName = "/protoapi.PlaylistApi/DeletePlaylist"
+	PlaylistApi_AddTrack_FullMethodName       = "/protoapi.PlaylistApi/AddTrack"
+	PlaylistApi_RemoveTrack_FullMethodName    = "/protoapi.PlaylistApi/RemoveTrack"
+	PlaylistApi_MoveTrack_FullMethodName      = "/protoapi.PlaylistApi/MoveTrack"
+	PlaylistApi_ExportM3U_FullMethodName      = "/protoapi.PlaylistApi/ExportM3U"
+	PlaylistApi_ExportXSPF_FullMethodName     = "/protoapi.PlaylistApi/ExportXSPF"
+)
+
+// PlaylistApiClient is the client API for PlaylistApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PlaylistApiClient interface {
+	CreatePlaylist(ctx context.Context, in *Playlist, opts ...grpc.CallOption) (*Playlist, error)
+	GetPlaylist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Playlist, error)
+	ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*PlaylistList, error)
+	RenamePlaylist(ctx context.Context, in *RenamePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error)
+	AddTrack(ctx context.Context, in *AddTrackRequest, opts ...grpc.CallOption) (*Playlist, error)
+	RemoveTrack(ctx context.Context, in *RemoveTrackRequest, opts ...grpc.CallOption) (*Playlist, error)
+	MoveTrack(ctx context.Context, in *MoveTrackRequest, opts ...grpc.CallOption) (*Playlist, error)
+	ExportM3U(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (PlaylistApi_ExportM3UClient, error)
+	ExportXSPF(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (PlaylistApi_ExportXSPFClient, error)
+}
+
+type playlistApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPlaylistApiClient(cc grpc.ClientConnInterface) PlaylistApiClient {
+	return &playlistApiClient{cc}
+}
+
+func (c *playlistApiClient) CreatePlaylist(ctx context.Context, in *Playlist, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, PlaylistApi_CreatePlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) GetPlaylist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, PlaylistApi_GetPlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*PlaylistList, error) {
+	out := new(PlaylistList)
+	err := c.cc.Invoke(ctx, PlaylistApi_ListPlaylists_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) RenamePlaylist(ctx context.Context, in *RenamePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, PlaylistApi_RenamePlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) DeletePlaylist(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.BoolValue, error) {
+	out := new(wrapperspb.BoolValue)
+	err := c.cc.Invoke(ctx, PlaylistApi_DeletePlaylist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) AddTrack(ctx context.Context, in *AddTrackRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, PlaylistApi_AddTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) RemoveTrack(ctx context.Context, in *RemoveTrackRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, PlaylistApi_RemoveTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) MoveTrack(ctx context.Context, in *MoveTrackRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, PlaylistApi_MoveTrack_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playlistApiClient) ExportM3U(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (PlaylistApi_ExportM3UClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaylistApi_ServiceDesc.Streams[0], PlaylistApi_ExportM3U_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playlistApiExportM3UClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaylistApi_ExportM3UClient interface {
+	Recv() (*PlaylistFile, error)
+	grpc.ClientStream
+}
+
+type playlistApiExportM3UClient struct {
+	grpc.ClientStream
+}
+
+func (x *playlistApiExportM3UClient) Recv() (*PlaylistFile, error) {
+	m := new(PlaylistFile)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *playlistApiClient) ExportXSPF(ctx context.Context, in *wrapperspb.StringValue, opts ...grpc.CallOption) (PlaylistApi_ExportXSPFClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PlaylistApi_ServiceDesc.Streams[1], PlaylistApi_ExportXSPF_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &playlistApiExportXSPFClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlaylistApi_ExportXSPFClient interface {
+	Recv() (*PlaylistFile, error)
+	grpc.ClientStream
+}
+
+type playlistApiExportXSPFClient struct {
+	grpc.ClientStream
+}
+
+func (x *playlistApiExportXSPFClient) Recv() (*PlaylistFile, error) {
+	m := new(PlaylistFile)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PlaylistApiServer is the server API for PlaylistApi service.
+// All implementations must embed UnimplementedPlaylistApiServer
+// for forward compatibility
+type PlaylistApiServer interface {
+	CreatePlaylist(context.Context, *Playlist) (*Playlist, error)
+	GetPlaylist(context.Context, *wrapperspb.StringValue) (*Playlist, error)
+	ListPlaylists(context.Context, *ListPlaylistsRequest) (*PlaylistList, error)
+	RenamePlaylist(context.Context, *RenamePlaylistRequest) (*Playlist, error)
+	DeletePlaylist(context.Context, *wrapperspb.StringValue) (*wrapperspb.BoolValue, error)
+	AddTrack(context.Context, *AddTrackRequest) (*Playlist, error)
+	RemoveTrack(context.Context, *RemoveTrackRequest) (*Playlist, error)
+	MoveTrack(context.Context, *MoveTrackRequest) (*Playlist, error)
+	ExportM3U(*wrapperspb.StringValue, PlaylistApi_ExportM3UServer) error
+	ExportXSPF(*wrapperspb.StringValue, PlaylistApi_ExportXSPFServer) error
+	mustEmbedUnimplementedPlaylistApiServer()
+}
+
+// UnimplementedPlaylistApiServer must be embedded to have forward compatible implementations.
+type UnimplementedPlaylistApiServer struct {
+}
+
+func (UnimplementedPlaylistApiServer) CreatePlaylist(context.Context, *Playlist) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedPlaylistApiServer) GetPlaylist(context.Context, *wrapperspb.StringValue) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (UnimplementedPlaylistApiServer) ListPlaylists(context.Context, *ListPlaylistsRequest) (*PlaylistList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedPlaylistApiServer) RenamePlaylist(context.Context, *RenamePlaylistRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenamePlaylist not implemented")
+}
+func (UnimplementedPlaylistApiServer) DeletePlaylist(context.Context, *wrapperspb.StringValue) (*wrapperspb.BoolValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedPlaylistApiServer) AddTrack(context.Context, *AddTrackRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrack not implemented")
+}
+func (UnimplementedPlaylistApiServer) RemoveTrack(context.Context, *RemoveTrackRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrack not implemented")
+}
+func (UnimplementedPlaylistApiServer) MoveTrack(context.Context, *MoveTrackRequest) (*Playlist, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveTrack not implemented")
+}
+func (UnimplementedPlaylistApiServer) ExportM3U(*wrapperspb.StringValue, PlaylistApi_ExportM3UServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportM3U not implemented")
+}
+func (UnimplementedPlaylistApiServer) ExportXSPF(*wrapperspb.StringValue, PlaylistApi_ExportXSPFServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportXSPF not implemented")
+}
+func (UnimplementedPlaylistApiServer) mustEmbedUnimplementedPlaylistApiServer() {}
+
+// UnsafePlaylistApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PlaylistApiServer will
+// result in compilation errors.
+type UnsafePlaylistApiServer interface {
+	mustEmbedUnimplementedPlaylistApiServer()
+}
+
+func RegisterPlaylistApiServer(s grpc.ServiceRegistrar, srv PlaylistApiServer) {
+	s.RegisterService(&PlaylistApi_ServiceDesc, srv)
+}
+
+func _PlaylistApi_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Playlist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_CreatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).CreatePlaylist(ctx, req.(*Playlist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).GetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_GetPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).GetPlaylist(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).ListPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_ListPlaylists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).ListPlaylists(ctx, req.(*ListPlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_RenamePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenamePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).RenamePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_RenamePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).RenamePlaylist(ctx, req.(*RenamePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_DeletePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).DeletePlaylist(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_AddTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).AddTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_AddTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).AddTrack(ctx, req.(*AddTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_RemoveTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).RemoveTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_RemoveTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).RemoveTrack(ctx, req.(*RemoveTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_MoveTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveTrackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaylistApiServer).MoveTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlaylistApi_MoveTrack_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaylistApiServer).MoveTrack(ctx, req.(*MoveTrackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaylistApi_ExportM3U_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(wrapperspb.StringValue)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaylistApiServer).ExportM3U(m, &playlistApiExportM3UServer{stream})
+}
+
+type PlaylistApi_ExportM3UServer interface {
+	Send(*PlaylistFile) error
+	grpc.ServerStream
+}
+
+type playlistApiExportM3UServer struct {
+	grpc.ServerStream
+}
+
+func (x *playlistApiExportM3UServer) Send(m *PlaylistFile) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlaylistApi_ExportXSPF_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(wrapperspb.StringValue)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlaylistApiServer).ExportXSPF(m, &playlistApiExportXSPFServer{stream})
+}
+
+type PlaylistApi_ExportXSPFServer interface {
+	Send(*PlaylistFile) error
+	grpc.ServerStream
+}
+
+type playlistApiExportXSPFServer struct {
+	grpc.ServerStream
+}
+
+func (x *playlistApiExportXSPFServer) Send(m *PlaylistFile) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// PlaylistApi_ServiceDesc is the grpc.ServiceDesc for PlaylistApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PlaylistApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "protoapi.PlaylistApi",
+	HandlerType: (*PlaylistApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _PlaylistApi_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "GetPlaylist",
+			Handler:    _PlaylistApi_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "ListPlaylists",
+			Handler:    _PlaylistApi_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "RenamePlaylist",
+			Handler:    _PlaylistApi_RenamePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _PlaylistApi_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "AddTrack",
+			Handler:    _PlaylistApi_AddTrack_Handler,
+		},
+		{
+			MethodName: "RemoveTrack",
+			Handler:    _PlaylistApi_RemoveTrack_Handler,
+		},
+		{
+			MethodName: "MoveTrack",
+			Handler:    _PlaylistApi_MoveTrack_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportM3U",
+			Handler:       _PlaylistApi_ExportM3U_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportXSPF",
+			Handler:       _PlaylistApi_ExportXSPF_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "musicplaylist.proto",
 }
@@ -0,0 +1,8 @@
+package musicplaylist
+
+import _ "embed"
+
+// OpenAPI is the OpenAPI v2 document of the REST gateway, generated from the google.api.http options of musicplaylist.proto.
+//
+//go:embed musicplaylist.swagger.json
+var OpenAPI []byte
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// SongAuditCollection is the name of the MongoDB collection where the audit trail of songs is stored.
+const SongAuditCollection = "song_audit"
+
+// SongAudit records a single change made to a song.
+type SongAudit struct {
+	ID     primitive.ObjectID `bson:"_id,omitempty"`    // Unique identifier for the audit entry
+	SongID primitive.ObjectID `bson:"song_id"`          // ID of the changed song
+	Actor  string             `bson:"actor"`            // Who made the change
+	RPC    string             `bson:"rpc"`              // Name of the RPC that made the change, e.g. "UpdateSong"
+	Time   time.Time          `bson:"time"`             // Time the change was made
+	Before *Song              `bson:"before,omitempty"` // Song before the change, nil when it did not exist
+	After  *Song              `bson:"after,omitempty"`  // Song after the change, nil when it no longer exists
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// DuplicateKey returns the key two songs with the same title and artist share,
+// ignoring case, punctuation and spacing. Songs without title or artist have no key.
+func (s Song) DuplicateKey() string {
+	title, artist := normalizeText(s.Title), normalizeText(s.Artist)
+	if title == "" || artist == "" {
+		return ""
+	}
+	return title + "\x00" + artist
+}
+
+// LinkKey returns the key two songs pointing to the same track share, ignoring the scheme,
+// a leading www., the case of the host and trailing slashes. Songs without link have no key.
+func (s Song) LinkKey() string {
+	link := strings.TrimSpace(SongLink(strings.TrimSpace(s.Link)))
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return link
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	key := host + strings.TrimRight(u.Path, "/")
+	if u.RawQuery != "" {
+		key += "?" + u.RawQuery
+	}
+	return key
+}
+
+// normalizeText lowercases a text and reduces every run of characters other than letters and digits to a single space.
+func normalizeText(s string) string {
+	fields := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
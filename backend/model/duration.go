@@ -0,0 +1,73 @@
+package model
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidDuration is returned when a duration string cannot be parsed or is shorter than a second.
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// ParseDuration parses the free-form duration of a song.
+// It accepts clock notation ("3:45", "1:02:03"), plain seconds ("225") and Go durations ("3m45s").
+// A song lasts at least a second, so "0", "0:00" and durations that would be displayed as "0:00" are rejected.
+func ParseDuration(s string) (time.Duration, error) {
+	d, err := parseDuration(strings.TrimSpace(s))
+	if err != nil || d < time.Second {
+		return 0, ErrInvalidDuration
+	}
+	return d, nil
+}
+
+// parseDuration parses a trimmed duration string without checking its length.
+func parseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	// Plain number of seconds
+	if secs, err := strconv.ParseUint(s, 10, 32); err == nil {
+		return time.Duration(secs) * time.Second, nil
+	}
+
+	// Clock notation, minutes:seconds or hours:minutes:seconds
+	if parts := strings.Split(s, ":"); len(parts) == 2 || len(parts) == 3 {
+		var total time.Duration
+		for i, part := range parts {
+			n, err := strconv.ParseUint(part, 10, 32)
+			if err != nil || (i > 0 && (n > 59 || len(part) != 2)) {
+				return 0, ErrInvalidDuration
+			}
+			total = total*60 + time.Duration(n)
+		}
+		return total * time.Second, nil
+	}
+
+	// Go duration notation
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, ErrInvalidDuration
+	}
+	return d, nil
+}
+
+// FormatDuration formats a duration in clock notation, "3:45" or "1:02:03" for durations of an hour or more.
+// Fractions of a second are dropped.
+func FormatDuration(d time.Duration) string {
+	secs := int64(d / time.Second)
+	h, m, s := secs/3600, secs/60%60, secs%60
+	if h > 0 {
+		return strconv.FormatInt(h, 10) + ":" + twoDigits(m) + ":" + twoDigits(s)
+	}
+	return strconv.FormatInt(m, 10) + ":" + twoDigits(s)
+}
+
+// twoDigits formats n with a leading zero when it is below ten.
+func twoDigits(n int64) string {
+	if n < 10 {
+		return "0" + strconv.FormatInt(n, 10)
+	}
+	return strconv.FormatInt(n, 10)
+}
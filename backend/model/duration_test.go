@@ -0,0 +1,93 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		// Plain seconds
+		{"225", 225 * time.Second, false},
+		{" 61 ", 61 * time.Second, false},
+		{"1", time.Second, false},
+		// Minutes and seconds
+		{"3:45", 3*time.Minute + 45*time.Second, false},
+		{"0:07", 7 * time.Second, false},
+		{"75:00", 75 * time.Minute, false},
+		// Hours, minutes and seconds
+		{"1:02:03", time.Hour + 2*time.Minute + 3*time.Second, false},
+		{"10:00:00", 10 * time.Hour, false},
+		// Go notation
+		{"3m45s", 3*time.Minute + 45*time.Second, false},
+		{"1h2m3s", time.Hour + 2*time.Minute + 3*time.Second, false},
+		{"1.5s", 1500 * time.Millisecond, false},
+		// Invalid input
+		{"", 0, true},
+		{"   ", 0, true},
+		{"abc", 0, true},
+		{"3:5", 0, true},
+		{"3:60", 0, true},
+		{"1:60:00", 0, true},
+		{"1:2:3:4", 0, true},
+		{"-3:45", 0, true},
+		{"-225", 0, true},
+		{"-3m", 0, true},
+		{"3:45:", 0, true},
+		// Zero and durations shorter than a second
+		{"0", 0, true},
+		{"0:00", 0, true},
+		{"0:00:00", 0, true},
+		{"0s", 0, true},
+		{"500ms", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		if tt.wantErr {
+			if err != ErrInvalidDuration {
+				t.Errorf("ParseDuration(%q) = %v, %v, want ErrInvalidDuration", tt.in, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("ParseDuration(%q) = %v, %v, want %v", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "0:00"},
+		{7 * time.Second, "0:07"},
+		{3*time.Minute + 45*time.Second, "3:45"},
+		{3*time.Minute + 45*time.Second + 999*time.Millisecond, "3:45"},
+		{59*time.Minute + 59*time.Second, "59:59"},
+		{time.Hour, "1:00:00"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "1:02:03"},
+		{25 * time.Hour, "25:00:00"},
+	}
+	for _, tt := range tests {
+		if got := FormatDuration(tt.in); got != tt.want {
+			t.Errorf("FormatDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFormatDurationParsesBack(t *testing.T) {
+	for _, s := range []string{"1", "225", "3m45s", "1:02:03", "10:00:00"} {
+		d, err := ParseDuration(s)
+		if err != nil {
+			t.Fatalf("ParseDuration(%q): %v", s, err)
+		}
+		if got, err := ParseDuration(FormatDuration(d)); err != nil || got != d {
+			t.Errorf("ParseDuration(FormatDuration(%v)) = %v, %v, want %v", d, got, err, d)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// SongEventType tells what kind of change a SongEvent describes.
+type SongEventType string
+
+const (
+	SongCreated SongEventType = "created" // The song was created or restored from the trash
+	SongUpdated SongEventType = "updated" // The fields of the song were changed
+	SongDeleted SongEventType = "deleted" // The song was moved to the trash
+)
+
+// SongEvent describes a single change made to a song, as streamed to watchers.
+type SongEvent struct {
+	Type        SongEventType // Kind of change
+	Song        Song          // Song after the change, or as it was before being deleted
+	ResumeToken string        // Opaque token to resume watching right after this event
+	Time        time.Time     // Time the change was made
+}
@@ -0,0 +1,152 @@
+package model
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// soundcloudTrackURL is the prefix of the URL of a SoundCloud track given by its numeric ID.
+const soundcloudTrackURL = "https://api.soundcloud.com/tracks/"
+
+// M3UWriter writes songs as an extended M3U playlist encoded in UTF-8, also known as M3U8.
+// Each song gets an #EXTINF line with its duration in seconds and "artist - title", followed by its URL.
+type M3UWriter struct {
+	w      *bufio.Writer
+	title  string
+	header bool
+}
+
+// NewM3UWriter creates an M3UWriter writing to w.
+// A non-empty title is written as the #PLAYLIST name of the file.
+func NewM3UWriter(w io.Writer, title string) *M3UWriter {
+	return &M3UWriter{w: bufio.NewWriter(w), title: title}
+}
+
+// WriteSong writes the entry of a song, songs without a duration get the duration -1 meaning unknown.
+func (m *M3UWriter) WriteSong(s Song) error {
+	m.writeHeader()
+	seconds := int64(-1)
+	if s.DurationMs > 0 {
+		seconds = (s.DurationMs + 500) / 1000
+	}
+	fmt.Fprintf(m.w, "#EXTINF:%d,%s\n%s\n", seconds, m3uLine(s.Artist+" - "+s.Title), m3uLine(SongURL(s.Link)))
+	return m.w.Flush()
+}
+
+// Close writes the header of playlists without songs and flushes the output.
+func (m *M3UWriter) Close() error {
+	m.writeHeader()
+	return m.w.Flush()
+}
+
+// writeHeader writes the #EXTM3U header once, before the first entry.
+func (m *M3UWriter) writeHeader() {
+	if m.header {
+		return
+	}
+	m.header = true
+	m.w.WriteString("#EXTM3U\n")
+	if m.title != "" {
+		fmt.Fprintf(m.w, "#PLAYLIST:%s\n", m3uLine(m.title))
+	}
+}
+
+// m3uLine keeps a value on a single line of an M3U file.
+func m3uLine(s string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(s)
+}
+
+// SongURL returns the URL of a song link, numeric SoundCloud track IDs become SoundCloud API URLs.
+func SongURL(link string) string {
+	if link != "" && strings.Trim(link, "0123456789") == "" {
+		return soundcloudTrackURL + link
+	}
+	return link
+}
+
+// SongLink returns the link of a song given by its URL, the inverse of SongURL.
+// SoundCloud API URLs of numeric track IDs become the track ID.
+func SongLink(url string) string {
+	if id := strings.TrimPrefix(url, soundcloudTrackURL); id != url && id != "" && strings.Trim(id, "0123456789") == "" {
+		return id
+	}
+	return url
+}
+
+// DecodeM3U reads the entries of an M3U or M3U8 playlist as songs.
+// The duration, artist and title come from the #EXTINF line preceding an entry, written as
+// "#EXTINF:225,Artist - Title", and the link is the URL line of the entry.
+// Entries without #EXTINF only have a link, and titles without " - " leave the artist empty.
+// Only DurationMs is set, the display duration is left empty.
+func DecodeM3U(r io.Reader) ([]Song, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var songs []Song
+	var info *Song // Song described by the last #EXTINF line, until its URL line is read
+	for first := true; scanner.Scan(); first = false {
+		line := strings.TrimSpace(scanner.Text())
+		if first {
+			line = strings.TrimPrefix(line, "\uFEFF")
+		}
+
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXTINF:"):
+			song := parseExtinf(strings.TrimPrefix(line, "#EXTINF:"))
+			info = &song
+		case strings.HasPrefix(line, "#"):
+			// Other directives and comments carry nothing a song can hold.
+		default:
+			song := Song{}
+			if info != nil {
+				song = *info
+			}
+			song.Link = SongLink(line)
+			songs = append(songs, song)
+			info = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return songs, nil
+}
+
+// parseExtinf parses the value of an #EXTINF line: the duration in seconds, optional attributes such as
+// tvg-name="...", then a comma and "artist - title".
+func parseExtinf(value string) Song {
+	// The display title starts after the first comma outside of quoted attribute values.
+	quoted := false
+	split := len(value)
+	for i, r := range value {
+		if r == '"' {
+			quoted = !quoted
+		}
+		if r == ',' && !quoted {
+			split = i
+			break
+		}
+	}
+	params, display := value[:split], ""
+	if split < len(value) {
+		display = strings.TrimSpace(value[split+1:])
+	}
+
+	var song Song
+	if fields := strings.Fields(params); len(fields) > 0 {
+		if seconds, err := strconv.ParseFloat(fields[0], 64); err == nil && seconds > 0 {
+			song.DurationMs = int64(seconds * 1000)
+		}
+	}
+	if artist, title, ok := strings.Cut(display, " - "); ok {
+		song.Artist = strings.TrimSpace(artist)
+		song.Title = strings.TrimSpace(title)
+	} else {
+		song.Title = display
+	}
+	return song
+}
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestM3URoundTrip(t *testing.T) {
+	songs := []Song{
+		{Title: "Title", Artist: "Artist", DurationMs: 225000, Link: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+		{Title: "Track", Artist: "Band", DurationMs: 61400, Link: "123456"},
+		{Title: "Unknown length", Artist: "Someone", Link: "https://soundcloud.com/someone/song"},
+	}
+
+	var buf bytes.Buffer
+	w := NewM3UWriter(&buf, "Road trip")
+	for _, s := range songs {
+		if err := w.WriteSong(s); err != nil {
+			t.Fatalf("WriteSong: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	want := "#EXTM3U\n" +
+		"#PLAYLIST:Road trip\n" +
+		"#EXTINF:225,Artist - Title\nhttps://www.youtube.com/watch?v=dQw4w9WgXcQ\n" +
+		"#EXTINF:61,Band - Track\nhttps://api.soundcloud.com/tracks/123456\n" +
+		"#EXTINF:-1,Someone - Unknown length\nhttps://soundcloud.com/someone/song\n"
+	if buf.String() != want {
+		t.Fatalf("M3U file is\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	got, err := DecodeM3U(&buf)
+	if err != nil {
+		t.Fatalf("DecodeM3U: %v", err)
+	}
+	// Durations are written in whole seconds.
+	songs[1].DurationMs = 61000
+	if !reflect.DeepEqual(got, songs) {
+		t.Fatalf("DecodeM3U returned %+v, want %+v", got, songs)
+	}
+}
+
+func TestM3UEmptyPlaylist(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewM3UWriter(&buf, "").Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if buf.String() != "#EXTM3U\n" {
+		t.Fatalf("empty M3U file is %q, want the header only", buf.String())
+	}
+
+	songs, err := DecodeM3U(&buf)
+	if err != nil || len(songs) != 0 {
+		t.Fatalf("DecodeM3U returned %v, %v, want no songs", songs, err)
+	}
+}
+
+func TestM3UKeepsValuesOnOneLine(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewM3UWriter(&buf, "Two\nlines")
+	w.WriteSong(Song{Title: "Line\r\nbreak", Artist: "Artist", Link: "https://youtu.be/x\nhttps://evil.example"})
+	w.Close()
+
+	want := "#EXTM3U\n#PLAYLIST:Two lines\n#EXTINF:-1,Artist - Line  break\nhttps://youtu.be/x https://evil.example\n"
+	if buf.String() != want {
+		t.Fatalf("M3U file is %q, want %q", buf.String(), want)
+	}
+}
+
+func TestDecodeM3UExtinf(t *testing.T) {
+	tests := []struct {
+		line string
+		want Song
+	}{
+		{"#EXTINF:225,Artist - Title", Song{Artist: "Artist", Title: "Title", DurationMs: 225000}},
+		{"#EXTINF:12.5,Artist - Title", Song{Artist: "Artist", Title: "Title", DurationMs: 12500}},
+		{"#EXTINF:-1,Artist - Title", Song{Artist: "Artist", Title: "Title"}},
+		{"#EXTINF:abc,Artist - Title", Song{Artist: "Artist", Title: "Title"}},
+		{"#EXTINF:90,Title only", Song{Title: "Title only", DurationMs: 90000}},
+		{"#EXTINF:90,AC/DC - Back in Black - Live", Song{Artist: "AC/DC", Title: "Back in Black - Live", DurationMs: 90000}},
+		{`#EXTINF:30 tvg-name="a, b" tvg-id="x",Artist - Title`, Song{Artist: "Artist", Title: "Title", DurationMs: 30000}},
+		{"#EXTINF:30", Song{DurationMs: 30000}},
+	}
+	for _, tt := range tests {
+		songs, err := DecodeM3U(strings.NewReader(tt.line + "\nhttps://youtu.be/x\n"))
+		if err != nil {
+			t.Fatalf("DecodeM3U(%q): %v", tt.line, err)
+		}
+		tt.want.Link = "https://youtu.be/x"
+		if len(songs) != 1 || !reflect.DeepEqual(songs[0], tt.want) {
+			t.Errorf("DecodeM3U(%q) returned %+v, want %+v", tt.line, songs, tt.want)
+		}
+	}
+}
+
+func TestDecodeM3UPlainList(t *testing.T) {
+	input := "\uFEFF#EXTM3U\r\n# a comment\r\n\r\nhttps://youtu.be/a\r\n#EXTINF:10,A - B\r\n#EXTVLCOPT:network-caching=1000\r\n987\r\n"
+	songs, err := DecodeM3U(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("DecodeM3U: %v", err)
+	}
+	want := []Song{
+		{Link: "https://youtu.be/a"},
+		{Artist: "A", Title: "B", DurationMs: 10000, Link: "987"},
+	}
+	if !reflect.DeepEqual(songs, want) {
+		t.Fatalf("DecodeM3U returned %+v, want %+v", songs, want)
+	}
+}
+
+func TestDecodeM3ULineTooLong(t *testing.T) {
+	input := "#EXTM3U\nhttps://youtu.be/" + strings.Repeat("x", 2<<20) + "\n"
+	if _, err := DecodeM3U(strings.NewReader(input)); err == nil {
+		t.Fatal("DecodeM3U of a line longer than 1 MiB succeeded, want an error")
+	}
+}
+
+func TestSongLinkInvertsSongURL(t *testing.T) {
+	for _, link := range []string{"123456", "https://www.youtube.com/watch?v=x", "https://soundcloud.com/a/b", ""} {
+		if got := SongLink(SongURL(link)); got != link {
+			t.Errorf("SongLink(SongURL(%q)) = %q", link, got)
+		}
+	}
+	if got := SongLink(soundcloudTrackURL + "12ab"); got != soundcloudTrackURL+"12ab" {
+		t.Errorf("SongLink kept only the non-numeric ID %q", got)
+	}
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PlaylistCollection is the name of the MongoDB collection where playlist documents are stored.
+const PlaylistCollection = "playlist"
+
+// Playlist represents a named, ordered list of songs.
+type Playlist struct {
+	ID          primitive.ObjectID   `bson:"_id,omitempty"` // Unique identifier for the playlist
+	Name        string               `bson:"name"`          // Name of the playlist
+	Description string               `bson:"description"`   // Description of the playlist
+	Owner       string               `bson:"owner"`         // Owner of the playlist
+	SongIDs     []primitive.ObjectID `bson:"song_ids"`      // Ordered IDs of the songs in the playlist
+	CreatedAt   time.Time            `bson:"created_at"`    // Time the playlist was created
+	UpdatedAt   time.Time            `bson:"updated_at"`    // Time the playlist was last changed
+}
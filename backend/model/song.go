@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
@@ -9,10 +11,22 @@ const SongCollection = "song"
 
 // Song represents a song in the music playlist.
 type Song struct {
-	ID       primitive.ObjectID `bson:"_id,omitempty"` // Unique identifier for the song
-	Title    string             `bson:"title"`          // Title of the song
-	Artist   string             `bson:"artist"`         // Artist of the song
-	Album    string             `bson:"album"`          // Album of the song
-	Duration string             `bson:"duration"`       // Duration of the song
-	Link     string             `bson:"link"`           // Link to the song (e.g., SoundCloud track number)
+	ID         primitive.ObjectID `bson:"_id,omitempty"`        // Unique identifier for the song
+	Title      string             `bson:"title"`                // Title of the song
+	Artist     string             `bson:"artist"`               // Artist of the song
+	Album      string             `bson:"album"`                // Album of the song
+	Duration   string             `bson:"duration"`             // Duration of the song for display, e.g. "3:45"
+	DurationMs int64              `bson:"duration_ms"`          // Duration of the song in milliseconds
+	Link       string             `bson:"link"`                 // Link to the song (e.g., SoundCloud track number)
+	Version    int64              `bson:"version"`              // Version of the song, incremented on every update
+	DeletedAt  *time.Time         `bson:"deleted_at,omitempty"` // Time the song was moved to the trash, nil unless deleted
+}
+
+// SongFilter describes the criteria used to search songs.
+type SongFilter struct {
+	Query       string        // Free-text query matched against title, artist and album
+	Artist      string        // Exact artist name, ignored when empty
+	Album       string        // Exact album name, ignored when empty
+	MinDuration time.Duration // Shortest duration to include, ignored when zero
+	MaxDuration time.Duration // Longest duration to include, ignored when zero
 }
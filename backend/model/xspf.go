@@ -0,0 +1,121 @@
+package model
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// xspfNamespace is the XML namespace of XSPF version 1 playlists.
+const xspfNamespace = "http://xspf.org/ns/0/"
+
+// xspfTrack is a track of an XSPF playlist, the fields a song can hold.
+type xspfTrack struct {
+	Location []string `xml:"location,omitempty"`
+	Title    string   `xml:"title,omitempty"`
+	Creator  string   `xml:"creator,omitempty"`
+	Album    string   `xml:"album,omitempty"`
+	Duration int64    `xml:"duration,omitempty"`
+}
+
+// XSPFWriter writes songs as an XSPF (XML Shareable Playlist Format) playlist.
+// The title, artist, album, duration and link of a song become the title, creator, album,
+// duration in milliseconds and location of a track.
+type XSPFWriter struct {
+	enc    *xml.Encoder
+	title  string
+	header bool
+}
+
+// NewXSPFWriter creates an XSPFWriter writing to w.
+// A non-empty title is written as the title of the playlist.
+func NewXSPFWriter(w io.Writer, title string) *XSPFWriter {
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return &XSPFWriter{enc: enc, title: title}
+}
+
+// WriteSong writes the track of a song.
+func (x *XSPFWriter) WriteSong(s Song) error {
+	if err := x.writeHeader(); err != nil {
+		return err
+	}
+	track := xspfTrack{
+		Title:    s.Title,
+		Creator:  s.Artist,
+		Album:    s.Album,
+		Duration: s.DurationMs,
+	}
+	if s.Link != "" {
+		track.Location = []string{SongURL(s.Link)}
+	}
+	if err := x.enc.EncodeElement(track, xml.StartElement{Name: xml.Name{Local: "track"}}); err != nil {
+		return err
+	}
+	return x.enc.Flush()
+}
+
+// Close ends the playlist and flushes the output, it must be called once all songs are written.
+func (x *XSPFWriter) Close() error {
+	if err := x.writeHeader(); err != nil {
+		return err
+	}
+	x.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "trackList"}})
+	x.enc.EncodeToken(xml.EndElement{Name: xml.Name{Local: "playlist"}})
+	if err := x.enc.Flush(); err != nil {
+		return err
+	}
+	return x.enc.Close()
+}
+
+// writeHeader opens the playlist and its track list once, before the first track.
+func (x *XSPFWriter) writeHeader() error {
+	if x.header {
+		return nil
+	}
+	x.header = true
+
+	x.enc.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="UTF-8"`)})
+	x.enc.EncodeToken(xml.CharData("\n"))
+	x.enc.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "playlist"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "version"}, Value: "1"},
+			{Name: xml.Name{Local: "xmlns"}, Value: xspfNamespace},
+		},
+	})
+	if x.title != "" {
+		x.enc.EncodeElement(x.title, xml.StartElement{Name: xml.Name{Local: "title"}})
+	}
+	return x.enc.EncodeToken(xml.StartElement{Name: xml.Name{Local: "trackList"}})
+}
+
+// DecodeXSPF reads the tracks of an XSPF playlist as songs.
+// The title, creator, album, duration in milliseconds and first location of a track become the title,
+// artist, album, duration and link of a song. Only DurationMs is set, the display duration is left empty.
+func DecodeXSPF(r io.Reader) ([]Song, error) {
+	var playlist struct {
+		XMLName xml.Name    `xml:"playlist"`
+		Tracks  []xspfTrack `xml:"trackList>track"`
+	}
+	if err := xml.NewDecoder(r).Decode(&playlist); err != nil {
+		return nil, err
+	}
+
+	songs := make([]Song, 0, len(playlist.Tracks))
+	for _, t := range playlist.Tracks {
+		song := Song{
+			Title:  strings.TrimSpace(t.Title),
+			Artist: strings.TrimSpace(t.Creator),
+			Album:  strings.TrimSpace(t.Album),
+		}
+		if len(t.Location) > 0 {
+			song.Link = SongLink(strings.TrimSpace(t.Location[0]))
+		}
+		if t.Duration > 0 {
+			song.DurationMs = t.Duration
+		}
+		songs = append(songs, song)
+	}
+	return songs, nil
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestXSPFRoundTrip(t *testing.T) {
+	songs := []Song{
+		{Title: "Title", Artist: "Artist", Album: "Album", DurationMs: 225000, Link: "https://www.youtube.com/watch?v=dQw4w9WgXcQ"},
+		{Title: "Track", Artist: "Band", DurationMs: 61400, Link: "123456"},
+		{Title: "No link", Artist: "Someone"},
+	}
+
+	var buf bytes.Buffer
+	w := NewXSPFWriter(&buf, "Road trip")
+	for _, s := range songs {
+		if err := w.WriteSong(s); err != nil {
+			t.Fatalf("WriteSong: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if !strings.Contains(buf.String(), "<location>https://api.soundcloud.com/tracks/123456</location>") {
+		t.Errorf("SoundCloud track ID not written as its URL:\n%s", buf.String())
+	}
+
+	got, err := DecodeXSPF(&buf)
+	if err != nil {
+		t.Fatalf("DecodeXSPF: %v", err)
+	}
+	if !reflect.DeepEqual(got, songs) {
+		t.Fatalf("DecodeXSPF returned %+v, want %+v", got, songs)
+	}
+}
+
+func TestXSPFEscapesXML(t *testing.T) {
+	song := Song{Title: `Rock & Roll <Live> "2024"`, Artist: "Simon & Garfunkel", Album: "<Greatest>", Link: "https://www.youtube.com/watch?v=a&t=10"}
+
+	var buf bytes.Buffer
+	w := NewXSPFWriter(&buf, "Tom & Jerry's <mix>")
+	if err := w.WriteSong(song); err != nil {
+		t.Fatalf("WriteSong: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"<title>Tom &amp; Jerry&#39;s &lt;mix&gt;</title>",
+		"<title>Rock &amp; Roll &lt;Live&gt; &#34;2024&#34;</title>",
+		"<creator>Simon &amp; Garfunkel</creator>",
+		"<location>https://www.youtube.com/watch?v=a&amp;t=10</location>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("XSPF file lacks %s:\n%s", want, out)
+		}
+	}
+
+	got, err := DecodeXSPF(&buf)
+	if err != nil {
+		t.Fatalf("DecodeXSPF: %v", err)
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0], song) {
+		t.Fatalf("DecodeXSPF returned %+v, want %+v", got, song)
+	}
+}
+
+func TestXSPFEmptyPlaylist(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewXSPFWriter(&buf, "").Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	want := "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+		"<playlist version=\"1\" xmlns=\"http://xspf.org/ns/0/\">\n" +
+		"  <trackList></trackList>\n" +
+		"</playlist>"
+	if buf.String() != want {
+		t.Fatalf("empty XSPF file is\n%s\nwant\n%s", buf.String(), want)
+	}
+
+	songs, err := DecodeXSPF(&buf)
+	if err != nil || len(songs) != 0 {
+		t.Fatalf("DecodeXSPF returned %v, %v, want no songs", songs, err)
+	}
+}
+
+func TestDecodeXSPF(t *testing.T) {
+	input := `<?xml version="1.0" encoding="UTF-8"?>
+<playlist version="1" xmlns="http://xspf.org/ns/0/">
+  <trackList>
+    <track>
+      <location> https://api.soundcloud.com/tracks/42 </location>
+      <location>https://mirror.example/42</location>
+      <title> Title </title>
+      <creator>Artist</creator>
+      <duration>1500</duration>
+      <image>https://example.com/cover.png</image>
+    </track>
+    <track>
+      <title>Untimed</title>
+      <duration>-3</duration>
+    </track>
+  </trackList>
+</playlist>`
+	songs, err := DecodeXSPF(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("DecodeXSPF: %v", err)
+	}
+	want := []Song{
+		{Title: "Title", Artist: "Artist", DurationMs: 1500, Link: "42"},
+		{Title: "Untimed"},
+	}
+	if !reflect.DeepEqual(songs, want) {
+		t.Fatalf("DecodeXSPF returned %+v, want %+v", songs, want)
+	}
+}
+
+func TestDecodeXSPFMalformed(t *testing.T) {
+	tests := map[string]string{
+		"not XML":        "#EXTM3U\nhttps://youtu.be/x\n",
+		"unclosed":       `<playlist version="1"><trackList><track><title>A</title>`,
+		"wrong root":     `<rss><trackList><track><title>A</title></track></trackList></rss>`,
+		"bad duration":   `<playlist><trackList><track><duration>long</duration></track></trackList></playlist>`,
+		"empty document": "",
+	}
+	for name, input := range tests {
+		if songs, err := DecodeXSPF(strings.NewReader(input)); err == nil {
+			t.Errorf("%s: DecodeXSPF returned %+v, want an error", name, songs)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"log"
+	"sync"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryAuditRepository keeps the audit trail of songs in memory.
+// It is safe for concurrent use and behaves like AuditRepository without needing MongoDB.
+type MemoryAuditRepository struct {
+	mu     sync.RWMutex
+	audits []model.SongAudit
+}
+
+// NewMemoryAuditRepo creates a new, empty instance of MemoryAuditRepository.
+func NewMemoryAuditRepo() *MemoryAuditRepository {
+	return &MemoryAuditRepository{}
+}
+
+// Record appends a new audit entry.
+// It takes a pointer to a model.SongAudit as input and returns the saved entry along with any error encountered.
+func (r *MemoryAuditRepository) Record(a *model.SongAudit) (model.SongAudit, error) {
+	log.Printf("Record(%s %s) \n", a.RPC, a.SongID.Hex())
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	audit := *a
+	audit.ID = primitive.NewObjectID()
+	audit.Before = copySong(a.Before)
+	audit.After = copySong(a.After)
+	r.audits = append(r.audits, audit)
+	return audit, nil
+}
+
+// FindBySong retrieves the audit entries of a song, newest first.
+// It returns ErrInvalidID if the song ID is malformed.
+func (r *MemoryAuditRepository) FindBySong(songID string) ([]model.SongAudit, error) {
+	log.Printf("FindBySong(%s) \n", songID)
+	oid, err := primitive.ObjectIDFromHex(songID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var audits []model.SongAudit
+	for i := len(r.audits) - 1; i >= 0; i-- {
+		if r.audits[i].SongID == oid {
+			audits = append(audits, r.audits[i])
+		}
+	}
+	return audits, nil
+}
+
+// FindByID retrieves a single audit entry by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no entry matches.
+func (r *MemoryAuditRepository) FindByID(id string) (model.SongAudit, error) {
+	log.Printf("FindByID(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.SongAudit{}, ErrInvalidID
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, audit := range r.audits {
+		if audit.ID == oid {
+			return audit, nil
+		}
+	}
+	return model.SongAudit{}, ErrNotFound
+}
+
+// copySong returns a copy of the song so stored snapshots cannot be changed by the caller.
+func copySong(song *model.Song) *model.Song {
+	if song == nil {
+		return nil
+	}
+	c := *song
+	return &c
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"log"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditRepository handles operations related to the audit trail of songs in the database.
+type AuditRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// NewAuditRepo creates a new instance of AuditRepository.
+func NewAuditRepo(db *mongo.Database) *AuditRepository {
+	return &AuditRepository{
+		db:  db,
+		col: db.Collection(model.SongAuditCollection),
+	}
+}
+
+// EnsureIndexes creates the index on song_id used to list the history of a song.
+func (r *AuditRepository) EnsureIndexes() error {
+	log.Println("EnsureIndexes()")
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	_, err := r.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "song_id", Value: 1}, {Key: "_id", Value: -1}},
+		Options: options.Index().SetName("song_audit_song_id"),
+	})
+	if err != nil {
+		log.Println(err)
+		return mongoError(err)
+	}
+
+	return nil
+}
+
+// Record inserts a new audit entry into the database.
+// It takes a pointer to a model.SongAudit as input and returns the saved entry along with any error encountered.
+func (r *AuditRepository) Record(a *model.SongAudit) (model.SongAudit, error) {
+	log.Printf("Record(%s %s) \n", a.RPC, a.SongID.Hex())
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	audit := *a
+	audit.ID = primitive.NewObjectID()
+	_, err := r.col.InsertOne(ctx, audit)
+	if err != nil {
+		log.Println(err)
+		return model.SongAudit{}, mongoError(err)
+	}
+
+	return audit, nil
+}
+
+// FindBySong retrieves the audit entries of a song, newest first.
+// It returns ErrInvalidID if the song ID is malformed.
+func (r *AuditRepository) FindBySong(songID string) ([]model.SongAudit, error) {
+	log.Printf("FindBySong(%s) \n", songID)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(songID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	var audits []model.SongAudit
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: -1}})
+	cur, err := r.col.Find(ctx, bson.M{"song_id": oid}, opts)
+	if err != nil {
+		log.Println(err)
+		return audits, mongoError(err)
+	}
+
+	defer cur.Close(ctx)
+	if err := cur.All(ctx, &audits); err != nil {
+		log.Println(err)
+		return nil, mongoError(err)
+	}
+
+	return audits, nil
+}
+
+// FindByID retrieves a single audit entry from the database by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no entry matches.
+func (r *AuditRepository) FindByID(id string) (model.SongAudit, error) {
+	log.Printf("FindByID(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var audit model.SongAudit
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return audit, ErrInvalidID
+	}
+
+	err = r.col.FindOne(ctx, bson.M{"_id": oid}).Decode(&audit)
+	if err != nil {
+		log.Println(err)
+		return audit, mongoError(err)
+	}
+
+	return audit, nil
+}
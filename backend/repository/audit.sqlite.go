@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// auditColumns lists the columns of the song_audit table in the order scanned by scanAudit.
+const auditColumns = "id, song_id, actor, rpc, time, before, after"
+
+// SQLiteAuditRepository handles operations related to the audit trail of songs in a SQLite database.
+// The before and after snapshots are stored as JSON documents.
+type SQLiteAuditRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteAuditRepo creates a new instance of SQLiteAuditRepository.
+// The database is expected to be opened with OpenSQLite.
+func NewSQLiteAuditRepo(db *sql.DB) *SQLiteAuditRepository {
+	return &SQLiteAuditRepository{db: db}
+}
+
+// Record inserts a new audit entry into the database.
+// It takes a pointer to a model.SongAudit as input and returns the saved entry along with any error encountered.
+func (r *SQLiteAuditRepository) Record(a *model.SongAudit) (model.SongAudit, error) {
+	log.Printf("Record(%s %s) \n", a.RPC, a.SongID.Hex())
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	audit := *a
+	audit.ID = primitive.NewObjectID()
+	before, err := encodeSnapshot(audit.Before)
+	if err != nil {
+		return model.SongAudit{}, err
+	}
+	after, err := encodeSnapshot(audit.After)
+	if err != nil {
+		return model.SongAudit{}, err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO song_audit ("+auditColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		audit.ID.Hex(), audit.SongID.Hex(), audit.Actor, audit.RPC, audit.Time.UnixMilli(), before, after)
+	if err != nil {
+		log.Println(err)
+		return model.SongAudit{}, sqliteError(err)
+	}
+
+	return audit, nil
+}
+
+// FindBySong retrieves the audit entries of a song, newest first.
+// It returns ErrInvalidID if the song ID is malformed.
+func (r *SQLiteAuditRepository) FindBySong(songID string) ([]model.SongAudit, error) {
+	log.Printf("FindBySong(%s) \n", songID)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(songID)
+	if err != nil {
+		return nil, ErrInvalidID
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+auditColumns+" FROM song_audit WHERE song_id = ? ORDER BY id DESC", oid.Hex())
+	if err != nil {
+		log.Println(err)
+		return nil, sqliteError(err)
+	}
+	defer rows.Close()
+
+	var audits []model.SongAudit
+	for rows.Next() {
+		audit, err := scanAudit(rows)
+		if err != nil {
+			log.Println(err)
+			return nil, sqliteError(err)
+		}
+		audits = append(audits, audit)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+		return nil, sqliteError(err)
+	}
+
+	return audits, nil
+}
+
+// FindByID retrieves a single audit entry from the database by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no entry matches.
+func (r *SQLiteAuditRepository) FindByID(id string) (model.SongAudit, error) {
+	log.Printf("FindByID(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.SongAudit{}, ErrInvalidID
+	}
+
+	row := r.db.QueryRowContext(ctx, "SELECT "+auditColumns+" FROM song_audit WHERE id = ?", oid.Hex())
+	audit, err := scanAudit(row)
+	if err != nil {
+		log.Println(err)
+		return model.SongAudit{}, sqliteError(err)
+	}
+
+	return audit, nil
+}
+
+// scanAudit reads an audit entry from a row holding auditColumns.
+func scanAudit(row scanner) (model.SongAudit, error) {
+	var audit model.SongAudit
+	var id, songID string
+	var at int64
+	var before, after sql.NullString
+	err := row.Scan(&id, &songID, &audit.Actor, &audit.RPC, &at, &before, &after)
+	if err != nil {
+		return audit, err
+	}
+	if audit.ID, err = primitive.ObjectIDFromHex(id); err != nil {
+		return audit, err
+	}
+	if audit.SongID, err = primitive.ObjectIDFromHex(songID); err != nil {
+		return audit, err
+	}
+	audit.Time = time.UnixMilli(at).UTC()
+	if audit.Before, err = decodeSnapshot(before); err != nil {
+		return audit, err
+	}
+	audit.After, err = decodeSnapshot(after)
+	return audit, err
+}
+
+// encodeSnapshot encodes a song snapshot as a JSON document, a nil song is stored as NULL.
+func encodeSnapshot(song *model.Song) (sql.NullString, error) {
+	if song == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(song)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+// decodeSnapshot decodes a song snapshot stored by encodeSnapshot.
+func decodeSnapshot(data sql.NullString) (*model.Song, error) {
+	if !data.Valid {
+		return nil, nil
+	}
+	var song model.Song
+	if err := json.Unmarshal([]byte(data.String), &song); err != nil {
+		return nil, err
+	}
+	return &song, nil
+}
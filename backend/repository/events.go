@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+)
+
+var (
+	// ErrInvalidResumeToken is returned when a resume token was not issued by the event source.
+	ErrInvalidResumeToken = errors.New("invalid resume token")
+	// ErrResumeTokenExpired is returned when the events following a resume token are no longer available.
+	ErrResumeTokenExpired = errors.New("resume token expired")
+)
+
+// SongWatcher streams the changes made to songs.
+// Watch calls fn for every change made after the event identified by resumeToken, or after the call
+// when resumeToken is empty, until ctx is done or fn returns an error.
+// SongEventBus implements it in process and SongRepository on top of MongoDB change streams.
+type SongWatcher interface {
+	Watch(ctx context.Context, resumeToken string, fn func(model.SongEvent) error) error
+}
+
+// defaultEventHistory is the number of events a SongEventBus keeps for watchers resuming with a token.
+const defaultEventHistory = 1000
+
+// SongEventBus delivers the changes published by the song service to the watchers of the same process.
+// It keeps the latest events so watchers can resume after reconnecting, tokens do not survive a restart.
+// It is safe for concurrent use.
+type SongEventBus struct {
+	mu      sync.Mutex
+	epoch   string            // Identifies this bus in resume tokens
+	seq     uint64            // Sequence number of the latest event
+	history []model.SongEvent // Latest events, oldest first
+	size    int               // Number of events kept in history
+	notify  chan struct{}     // Closed and replaced whenever an event is published
+}
+
+// NewSongEventBus creates a new instance of SongEventBus keeping the latest 1000 events.
+func NewSongEventBus() *SongEventBus {
+	return &SongEventBus{
+		epoch:  strconv.FormatInt(time.Now().UnixNano(), 36),
+		size:   defaultEventHistory,
+		notify: make(chan struct{}),
+	}
+}
+
+// Publish delivers an event to all watchers.
+// The resume token of the event is assigned by the bus.
+func (b *SongEventBus) Publish(e model.SongEvent) {
+	log.Printf("Publish(%s %s) \n", e.Type, e.Song.ID.Hex())
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	e.ResumeToken = b.token(b.seq)
+	b.history = append(b.history, e)
+	if len(b.history) > b.size {
+		b.history = b.history[len(b.history)-b.size:]
+	}
+	close(b.notify)
+	b.notify = make(chan struct{})
+}
+
+// Watch calls fn for every event published after the event identified by resumeToken, or after the call
+// when resumeToken is empty, until ctx is done or fn returns an error.
+// It returns ErrInvalidResumeToken for tokens of another bus and ErrResumeTokenExpired when the events
+// following the token were dropped from the history.
+func (b *SongEventBus) Watch(ctx context.Context, resumeToken string, fn func(model.SongEvent) error) error {
+	log.Printf("Watch(%s) \n", resumeToken)
+	b.mu.Lock()
+	last := b.seq
+	b.mu.Unlock()
+	if resumeToken != "" {
+		var err error
+		if last, err = b.parseToken(resumeToken); err != nil {
+			return err
+		}
+	}
+
+	for {
+		b.mu.Lock()
+		events, err := b.since(last)
+		notify := b.notify
+		b.mu.Unlock()
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+			last++
+		}
+
+		if len(events) == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-notify:
+			}
+		}
+	}
+}
+
+// since returns the events published after the event with sequence number last, the caller must hold the lock.
+func (b *SongEventBus) since(last uint64) ([]model.SongEvent, error) {
+	if last > b.seq {
+		return nil, ErrInvalidResumeToken
+	}
+	missing := b.seq - last
+	if missing > uint64(len(b.history)) {
+		return nil, ErrResumeTokenExpired
+	}
+	events := b.history[uint64(len(b.history))-missing:]
+	return append([]model.SongEvent(nil), events...), nil
+}
+
+// token returns the resume token of the event with the given sequence number.
+func (b *SongEventBus) token(seq uint64) string {
+	return fmt.Sprintf("%s.%d", b.epoch, seq)
+}
+
+// parseToken returns the sequence number of the event identified by a resume token.
+func (b *SongEventBus) parseToken(token string) (uint64, error) {
+	epoch, seq, ok := strings.Cut(token, ".")
+	if !ok {
+		return 0, ErrInvalidResumeToken
+	}
+	if epoch != b.epoch {
+		return 0, ErrResumeTokenExpired
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidResumeToken
+	}
+	return n, nil
+}
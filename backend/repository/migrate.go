@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"log"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+)
+
+// MigrateDurations fills in DurationMs for songs stored before durations were structured
+// and normalizes their display string.
+// Songs whose duration string cannot be parsed are logged and left unchanged.
+// It returns the number of migrated and skipped songs along with any error encountered.
+func MigrateDurations(store SongStore) (migrated, skipped int, err error) {
+	log.Println("MigrateDurations()")
+	songs, err := store.FindAll()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for _, song := range songs {
+		if song.DurationMs > 0 {
+			continue
+		}
+		d, err := model.ParseDuration(song.Duration)
+		if err != nil {
+			log.Printf("Skipping song %s with invalid duration %q \n", song.ID.Hex(), song.Duration)
+			skipped++
+			continue
+		}
+		song.Duration = model.FormatDuration(d)
+		song.DurationMs = d.Milliseconds()
+		if _, err := store.Update(&song); err != nil {
+			return migrated, skipped, err
+		}
+		migrated++
+	}
+
+	return migrated, skipped, nil
+}
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemoryPlaylistRepository keeps playlists in memory.
+// It is safe for concurrent use and behaves like PlaylistRepository without needing MongoDB.
+type MemoryPlaylistRepository struct {
+	mu        sync.RWMutex
+	playlists map[primitive.ObjectID]model.Playlist
+}
+
+// NewMemoryPlaylistRepo creates a new, empty instance of MemoryPlaylistRepository.
+func NewMemoryPlaylistRepo() *MemoryPlaylistRepository {
+	return &MemoryPlaylistRepository{
+		playlists: make(map[primitive.ObjectID]model.Playlist),
+	}
+}
+
+// Save inserts a new playlist into memory.
+// It takes a pointer to a model.Playlist as input and returns the saved playlist along with any error encountered.
+func (r *MemoryPlaylistRepository) Save(p *model.Playlist) (model.Playlist, error) {
+	log.Printf("Save(%v) \n", p)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	playlist := *p
+	now := time.Now().UTC()
+	playlist.ID = primitive.NewObjectID()
+	playlist.CreatedAt = now
+	playlist.UpdatedAt = now
+	playlist.SongIDs = append([]primitive.ObjectID{}, p.SongIDs...)
+	r.playlists[playlist.ID] = playlist
+	return copyPlaylist(playlist), nil
+}
+
+// FindByID retrieves a single playlist by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no playlist matches.
+func (r *MemoryPlaylistRepository) FindByID(id string) (model.Playlist, error) {
+	log.Printf("FindByID(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Playlist{}, ErrInvalidID
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	playlist, ok := r.playlists[oid]
+	if !ok {
+		return model.Playlist{}, ErrNotFound
+	}
+	return copyPlaylist(playlist), nil
+}
+
+// FindAll retrieves all playlists ordered by name, optionally restricted to one owner.
+// It returns a slice of playlists along with any error encountered.
+func (r *MemoryPlaylistRepository) FindAll(owner string) ([]model.Playlist, error) {
+	log.Printf("FindAll(%s) \n", owner)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var playlists []model.Playlist
+	for _, playlist := range r.playlists {
+		if owner != "" && playlist.Owner != owner {
+			continue
+		}
+		playlists = append(playlists, copyPlaylist(playlist))
+	}
+	sort.Slice(playlists, func(i, j int) bool {
+		return playlists[i].Name < playlists[j].Name
+	})
+	return playlists, nil
+}
+
+// Rename changes the name and description of a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *MemoryPlaylistRepository) Rename(id, name, description string) (model.Playlist, error) {
+	log.Printf("Rename(%s, %s) \n", id, name)
+	return r.update(id, func(p *model.Playlist) error {
+		p.Name = name
+		p.Description = description
+		return nil
+	})
+}
+
+// AddTrack appends a song to the end of a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *MemoryPlaylistRepository) AddTrack(id string, songID primitive.ObjectID) (model.Playlist, error) {
+	log.Printf("AddTrack(%s, %s) \n", id, songID.Hex())
+	return r.update(id, func(p *model.Playlist) error {
+		p.SongIDs = append(p.SongIDs, songID)
+		return nil
+	})
+}
+
+// RemoveTrack removes the song at the given position from a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *MemoryPlaylistRepository) RemoveTrack(id string, position int) (model.Playlist, error) {
+	log.Printf("RemoveTrack(%s, %d) \n", id, position)
+	return r.update(id, func(p *model.Playlist) error {
+		if position < 0 || position >= len(p.SongIDs) {
+			return ErrInvalidPosition
+		}
+		p.SongIDs = append(p.SongIDs[:position:position], p.SongIDs[position+1:]...)
+		return nil
+	})
+}
+
+// MoveTrack moves the song at position from to position to, shifting the songs in between.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *MemoryPlaylistRepository) MoveTrack(id string, from, to int) (model.Playlist, error) {
+	log.Printf("MoveTrack(%s, %d, %d) \n", id, from, to)
+	return r.update(id, func(p *model.Playlist) error {
+		if from < 0 || from >= len(p.SongIDs) || to < 0 || to >= len(p.SongIDs) {
+			return ErrInvalidPosition
+		}
+		moved := p.SongIDs[from]
+		rest := append(p.SongIDs[:from:from], p.SongIDs[from+1:]...)
+		result := make([]primitive.ObjectID, 0, len(p.SongIDs))
+		result = append(result, rest[:to]...)
+		result = append(result, moved)
+		p.SongIDs = append(result, rest[to:]...)
+		return nil
+	})
+}
+
+// Delete deletes a playlist by its ID.
+// It takes a string representing the playlist ID as input and returns a boolean indicating the deletion success along with any error encountered.
+func (r *MemoryPlaylistRepository) Delete(id string) (bool, error) {
+	log.Printf("Delete(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		log.Printf("Invalid PlaylistID(%s) \n", id)
+		return false, ErrInvalidID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.playlists[oid]; !ok {
+		return false, ErrNotFound
+	}
+	delete(r.playlists, oid)
+	return true, nil
+}
+
+// update applies fn to a copy of the playlist and stores the result unless fn fails.
+func (r *MemoryPlaylistRepository) update(id string, fn func(*model.Playlist) error) (model.Playlist, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Playlist{}, ErrInvalidID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.playlists[oid]
+	if !ok {
+		return model.Playlist{}, ErrNotFound
+	}
+	playlist := copyPlaylist(stored)
+	if err := fn(&playlist); err != nil {
+		return copyPlaylist(stored), err
+	}
+	playlist.UpdatedAt = time.Now().UTC()
+	r.playlists[oid] = playlist
+	return copyPlaylist(playlist), nil
+}
+
+// copyPlaylist returns a copy of the playlist that does not share its track list.
+func copyPlaylist(p model.Playlist) model.Playlist {
+	p.SongIDs = append([]primitive.ObjectID{}, p.SongIDs...)
+	return p
+}
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PlaylistRepository handles operations related to playlists in the database.
+type PlaylistRepository struct {
+	db  *mongo.Database
+	col *mongo.Collection
+}
+
+// NewPlaylistRepo creates a new instance of PlaylistRepository.
+func NewPlaylistRepo(db *mongo.Database) *PlaylistRepository {
+	return &PlaylistRepository{
+		db:  db,
+		col: db.Collection(model.PlaylistCollection),
+	}
+}
+
+// Save inserts a new playlist into the database.
+// It takes a pointer to a model.Playlist as input and returns the saved playlist along with any error encountered.
+func (r *PlaylistRepository) Save(p *model.Playlist) (model.Playlist, error) {
+	log.Printf("Save(%v) \n", p)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	now := time.Now().UTC()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+	if p.SongIDs == nil {
+		p.SongIDs = []primitive.ObjectID{}
+	}
+
+	var playlist model.Playlist
+	res, err := r.col.InsertOne(ctx, p)
+	if err != nil {
+		log.Println(err)
+		return playlist, mongoError(err)
+	}
+
+	err = r.col.FindOne(ctx, bson.M{"_id": res.InsertedID}).Decode(&playlist)
+	if err != nil {
+		log.Println(err)
+		return playlist, mongoError(err)
+	}
+
+	return playlist, nil
+}
+
+// FindByID retrieves a single playlist from the database by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no playlist matches.
+func (r *PlaylistRepository) FindByID(id string) (model.Playlist, error) {
+	log.Printf("FindByID(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var playlist model.Playlist
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return playlist, ErrInvalidID
+	}
+
+	err = r.col.FindOne(ctx, bson.M{"_id": oid}).Decode(&playlist)
+	if err != nil {
+		log.Println(err)
+		return playlist, mongoError(err)
+	}
+
+	return playlist, nil
+}
+
+// FindAll retrieves all playlists from the database, optionally restricted to one owner.
+// It returns a slice of playlists along with any error encountered.
+func (r *PlaylistRepository) FindAll(owner string) ([]model.Playlist, error) {
+	log.Printf("FindAll(%s) \n", owner)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if owner != "" {
+		filter["owner"] = owner
+	}
+
+	var playlists []model.Playlist
+	cur, err := r.col.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		log.Println(err)
+		return playlists, mongoError(err)
+	}
+
+	defer cur.Close(ctx)
+	if err := cur.All(ctx, &playlists); err != nil {
+		log.Println(err)
+		return nil, mongoError(err)
+	}
+
+	return playlists, nil
+}
+
+// Rename changes the name and description of a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *PlaylistRepository) Rename(id, name, description string) (model.Playlist, error) {
+	log.Printf("Rename(%s, %s) \n", id, name)
+	return r.update(id, bson.M{
+		"$set": bson.M{
+			"name":        name,
+			"description": description,
+			"updated_at":  time.Now().UTC(),
+		},
+	})
+}
+
+// AddTrack appends a song to the end of a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *PlaylistRepository) AddTrack(id string, songID primitive.ObjectID) (model.Playlist, error) {
+	log.Printf("AddTrack(%s, %s) \n", id, songID.Hex())
+	return r.update(id, bson.M{
+		"$push": bson.M{"song_ids": songID},
+		"$set":  bson.M{"updated_at": time.Now().UTC()},
+	})
+}
+
+// RemoveTrack removes the song at the given position from a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *PlaylistRepository) RemoveTrack(id string, position int) (model.Playlist, error) {
+	log.Printf("RemoveTrack(%s, %d) \n", id, position)
+	return r.reorder(id, func(songIDs []primitive.ObjectID) ([]primitive.ObjectID, error) {
+		if position < 0 || position >= len(songIDs) {
+			return nil, ErrInvalidPosition
+		}
+		return append(songIDs[:position:position], songIDs[position+1:]...), nil
+	})
+}
+
+// MoveTrack moves the song at position from to position to, shifting the songs in between.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *PlaylistRepository) MoveTrack(id string, from, to int) (model.Playlist, error) {
+	log.Printf("MoveTrack(%s, %d, %d) \n", id, from, to)
+	return r.reorder(id, func(songIDs []primitive.ObjectID) ([]primitive.ObjectID, error) {
+		if from < 0 || from >= len(songIDs) || to < 0 || to >= len(songIDs) {
+			return nil, ErrInvalidPosition
+		}
+		moved := songIDs[from]
+		rest := append(songIDs[:from:from], songIDs[from+1:]...)
+		result := make([]primitive.ObjectID, 0, len(songIDs))
+		result = append(result, rest[:to]...)
+		result = append(result, moved)
+		return append(result, rest[to:]...), nil
+	})
+}
+
+// Delete deletes a playlist from the database by its ID.
+// It takes a string representing the playlist ID as input and returns a boolean indicating the deletion success along with any error encountered.
+func (r *PlaylistRepository) Delete(id string) (bool, error) {
+	log.Printf("Delete(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var playlist model.Playlist
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		log.Printf("Invalid PlaylistID(%s) \n", id)
+		return false, ErrInvalidID
+	}
+	err = r.col.FindOneAndDelete(ctx, bson.M{"_id": oid}).Decode(&playlist)
+	if err != nil {
+		log.Printf("Fail to delete playlist: %v \n", err)
+		return false, mongoError(err)
+	}
+	log.Printf("Deleted_playlist(%v) \n", playlist)
+	return true, nil
+}
+
+// update applies an update document to a playlist and returns the playlist as stored afterwards.
+func (r *PlaylistRepository) update(id string, update bson.M) (model.Playlist, error) {
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var playlist model.Playlist
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return playlist, ErrInvalidID
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = r.col.FindOneAndUpdate(ctx, bson.M{"_id": oid}, update, opts).Decode(&playlist)
+	if err != nil {
+		log.Println(err)
+		return playlist, mongoError(err)
+	}
+
+	return playlist, nil
+}
+
+// reorder rewrites the track list of a playlist using fn.
+// The write only succeeds if the track list is unchanged since it was read, otherwise ErrConcurrentUpdate is returned.
+func (r *PlaylistRepository) reorder(id string, fn func([]primitive.ObjectID) ([]primitive.ObjectID, error)) (model.Playlist, error) {
+	current, err := r.FindByID(id)
+	if err != nil {
+		return current, err
+	}
+
+	songIDs, err := fn(append([]primitive.ObjectID(nil), current.SongIDs...))
+	if err != nil {
+		return current, err
+	}
+
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var playlist model.Playlist
+	filter := bson.M{"_id": current.ID, "song_ids": current.SongIDs}
+	update := bson.M{
+		"$set": bson.M{
+			"song_ids":   songIDs,
+			"updated_at": time.Now().UTC(),
+		},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&playlist)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return playlist, ErrConcurrentUpdate
+	}
+	if err != nil {
+		log.Println(err)
+		return playlist, mongoError(err)
+	}
+
+	return playlist, nil
+}
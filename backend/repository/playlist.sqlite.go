@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"log"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// playlistColumns lists the columns of the playlist table in the order scanned by scanPlaylist.
+const playlistColumns = "id, name, description, owner, song_ids, created_at, updated_at"
+
+// SQLitePlaylistRepository handles operations related to playlists in a SQLite database.
+// The ordered track list is stored as a JSON array of song IDs.
+type SQLitePlaylistRepository struct {
+	db *sql.DB
+}
+
+// NewSQLitePlaylistRepo creates a new instance of SQLitePlaylistRepository.
+// The database is expected to be opened with OpenSQLite.
+func NewSQLitePlaylistRepo(db *sql.DB) *SQLitePlaylistRepository {
+	return &SQLitePlaylistRepository{db: db}
+}
+
+// Save inserts a new playlist into the database.
+// It takes a pointer to a model.Playlist as input and returns the saved playlist along with any error encountered.
+func (r *SQLitePlaylistRepository) Save(p *model.Playlist) (model.Playlist, error) {
+	log.Printf("Save(%v) \n", p)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	playlist := *p
+	now := time.Now().UTC().Truncate(time.Millisecond)
+	playlist.ID = primitive.NewObjectID()
+	playlist.CreatedAt = now
+	playlist.UpdatedAt = now
+	playlist.SongIDs = append([]primitive.ObjectID{}, p.SongIDs...)
+
+	songIDs, err := encodeSongIDs(playlist.SongIDs)
+	if err != nil {
+		return model.Playlist{}, err
+	}
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO playlist ("+playlistColumns+") VALUES (?, ?, ?, ?, ?, ?, ?)",
+		playlist.ID.Hex(), playlist.Name, playlist.Description, playlist.Owner, songIDs,
+		playlist.CreatedAt.UnixMilli(), playlist.UpdatedAt.UnixMilli())
+	if err != nil {
+		log.Println(err)
+		return model.Playlist{}, sqliteError(err)
+	}
+
+	return playlist, nil
+}
+
+// FindByID retrieves a single playlist from the database by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no playlist matches.
+func (r *SQLitePlaylistRepository) FindByID(id string) (model.Playlist, error) {
+	log.Printf("FindByID(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Playlist{}, ErrInvalidID
+	}
+
+	row := r.db.QueryRowContext(ctx, "SELECT "+playlistColumns+" FROM playlist WHERE id = ?", oid.Hex())
+	playlist, err := scanPlaylist(row)
+	if err != nil {
+		log.Println(err)
+		return model.Playlist{}, sqliteError(err)
+	}
+
+	return playlist, nil
+}
+
+// FindAll retrieves all playlists from the database ordered by name, optionally restricted to one owner.
+// It returns a slice of playlists along with any error encountered.
+func (r *SQLitePlaylistRepository) FindAll(owner string) ([]model.Playlist, error) {
+	log.Printf("FindAll(%s) \n", owner)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+playlistColumns+" FROM playlist WHERE ? = '' OR owner = ? ORDER BY name", owner, owner)
+	if err != nil {
+		log.Println(err)
+		return nil, sqliteError(err)
+	}
+	defer rows.Close()
+
+	var playlists []model.Playlist
+	for rows.Next() {
+		playlist, err := scanPlaylist(rows)
+		if err != nil {
+			log.Println(err)
+			return nil, sqliteError(err)
+		}
+		playlists = append(playlists, playlist)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+		return nil, sqliteError(err)
+	}
+
+	return playlists, nil
+}
+
+// Rename changes the name and description of a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *SQLitePlaylistRepository) Rename(id, name, description string) (model.Playlist, error) {
+	log.Printf("Rename(%s, %s) \n", id, name)
+	return r.update(id, func(p *model.Playlist) error {
+		p.Name = name
+		p.Description = description
+		return nil
+	})
+}
+
+// AddTrack appends a song to the end of a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *SQLitePlaylistRepository) AddTrack(id string, songID primitive.ObjectID) (model.Playlist, error) {
+	log.Printf("AddTrack(%s, %s) \n", id, songID.Hex())
+	return r.update(id, func(p *model.Playlist) error {
+		p.SongIDs = append(p.SongIDs, songID)
+		return nil
+	})
+}
+
+// RemoveTrack removes the song at the given position from a playlist.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *SQLitePlaylistRepository) RemoveTrack(id string, position int) (model.Playlist, error) {
+	log.Printf("RemoveTrack(%s, %d) \n", id, position)
+	return r.update(id, func(p *model.Playlist) error {
+		if position < 0 || position >= len(p.SongIDs) {
+			return ErrInvalidPosition
+		}
+		p.SongIDs = append(p.SongIDs[:position:position], p.SongIDs[position+1:]...)
+		return nil
+	})
+}
+
+// MoveTrack moves the song at position from to position to, shifting the songs in between.
+// It returns the playlist as stored after the change along with any error encountered.
+func (r *SQLitePlaylistRepository) MoveTrack(id string, from, to int) (model.Playlist, error) {
+	log.Printf("MoveTrack(%s, %d, %d) \n", id, from, to)
+	return r.update(id, func(p *model.Playlist) error {
+		if from < 0 || from >= len(p.SongIDs) || to < 0 || to >= len(p.SongIDs) {
+			return ErrInvalidPosition
+		}
+		moved := p.SongIDs[from]
+		rest := append(p.SongIDs[:from:from], p.SongIDs[from+1:]...)
+		result := make([]primitive.ObjectID, 0, len(p.SongIDs))
+		result = append(result, rest[:to]...)
+		result = append(result, moved)
+		p.SongIDs = append(result, rest[to:]...)
+		return nil
+	})
+}
+
+// Delete deletes a playlist from the database by its ID.
+// It takes a string representing the playlist ID as input and returns a boolean indicating the deletion success along with any error encountered.
+func (r *SQLitePlaylistRepository) Delete(id string) (bool, error) {
+	log.Printf("Delete(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		log.Printf("Invalid PlaylistID(%s) \n", id)
+		return false, ErrInvalidID
+	}
+
+	res, err := r.db.ExecContext(ctx, "DELETE FROM playlist WHERE id = ?", oid.Hex())
+	if err != nil {
+		log.Printf("Fail to delete playlist: %v \n", err)
+		return false, sqliteError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		log.Printf("Fail to delete playlist: %v \n", ErrNotFound)
+		return false, ErrNotFound
+	}
+	return true, nil
+}
+
+// update applies fn to the playlist inside a transaction and stores the result unless fn fails.
+func (r *SQLitePlaylistRepository) update(id string, fn func(*model.Playlist) error) (model.Playlist, error) {
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Playlist{}, ErrInvalidID
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return model.Playlist{}, sqliteError(err)
+	}
+	defer tx.Rollback()
+
+	row := tx.QueryRowContext(ctx, "SELECT "+playlistColumns+" FROM playlist WHERE id = ?", oid.Hex())
+	playlist, err := scanPlaylist(row)
+	if err != nil {
+		log.Println(err)
+		return model.Playlist{}, sqliteError(err)
+	}
+	if err := fn(&playlist); err != nil {
+		return model.Playlist{}, err
+	}
+	playlist.UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+
+	songIDs, err := encodeSongIDs(playlist.SongIDs)
+	if err != nil {
+		return model.Playlist{}, err
+	}
+	_, err = tx.ExecContext(ctx,
+		"UPDATE playlist SET name = ?, description = ?, song_ids = ?, updated_at = ? WHERE id = ?",
+		playlist.Name, playlist.Description, songIDs, playlist.UpdatedAt.UnixMilli(), oid.Hex())
+	if err != nil {
+		log.Println(err)
+		return model.Playlist{}, sqliteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return model.Playlist{}, sqliteError(err)
+	}
+	return playlist, nil
+}
+
+// scanPlaylist reads a playlist from a row holding playlistColumns.
+func scanPlaylist(row scanner) (model.Playlist, error) {
+	var playlist model.Playlist
+	var id, songIDs string
+	var createdAt, updatedAt int64
+	err := row.Scan(&id, &playlist.Name, &playlist.Description, &playlist.Owner, &songIDs, &createdAt, &updatedAt)
+	if err != nil {
+		return playlist, err
+	}
+	if playlist.ID, err = primitive.ObjectIDFromHex(id); err != nil {
+		return playlist, err
+	}
+	if err = json.Unmarshal([]byte(songIDs), &playlist.SongIDs); err != nil {
+		return playlist, err
+	}
+	playlist.CreatedAt = time.UnixMilli(createdAt).UTC()
+	playlist.UpdatedAt = time.UnixMilli(updatedAt).UTC()
+	return playlist, nil
+}
+
+// encodeSongIDs stores the track list of a playlist as a JSON array of hex IDs.
+func encodeSongIDs(songIDs []primitive.ObjectID) (string, error) {
+	if songIDs == nil {
+		songIDs = []primitive.ObjectID{}
+	}
+	b, err := json.Marshal(songIDs)
+	return string(b), err
+}
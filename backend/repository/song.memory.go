@@ -0,0 +1,338 @@
+package repository
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MemorySongRepository keeps songs in memory.
+// It is safe for concurrent use and behaves like SongRepository without needing MongoDB.
+type MemorySongRepository struct {
+	mu    sync.RWMutex
+	songs map[primitive.ObjectID]model.Song
+}
+
+// NewMemorySongRepo creates a new, empty instance of MemorySongRepository.
+func NewMemorySongRepo() *MemorySongRepository {
+	return &MemorySongRepository{
+		songs: make(map[primitive.ObjectID]model.Song),
+	}
+}
+
+// Save inserts a new song into memory.
+// It takes a pointer to a model.Song as input and returns the saved song along with any error encountered.
+func (r *MemorySongRepository) Save(u *model.Song) (model.Song, error) {
+	log.Printf("Save(%v) \n", u)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	song := *u
+	if song.ID.IsZero() {
+		song.ID = primitive.NewObjectID()
+	}
+	if _, ok := r.songs[song.ID]; ok {
+		return model.Song{}, ErrAlreadyExists
+	}
+	song.Version = 1
+	song.DeletedAt = nil
+	r.songs[song.ID] = song
+	return song, nil
+}
+
+// SaveMany stores a batch of new songs, a song that cannot be stored does not prevent the others from being stored.
+// It returns the stored songs in batch order and the songs that failed.
+func (r *MemorySongRepository) SaveMany(songs []*model.Song) ([]model.Song, []SaveFailure, error) {
+	log.Printf("SaveMany(%d songs) \n", len(songs))
+
+	var saved []model.Song
+	var failures []SaveFailure
+	for i, u := range songs {
+		song, err := r.Save(u)
+		if err != nil {
+			failures = append(failures, SaveFailure{Index: i, Err: err})
+			continue
+		}
+		saved = append(saved, song)
+	}
+	return saved, failures, nil
+}
+
+// FindAll retrieves all songs that are not in the trash ordered by ID.
+// It returns a slice of songs along with any error encountered.
+func (r *MemorySongRepository) FindAll() ([]model.Song, error) {
+	log.Println("FindAll()")
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.sorted(false), nil
+}
+
+// FindPage retrieves at most limit songs ordered by ID, starting after the song with the given ID.
+// An empty after value starts from the first song.
+// It returns a slice of songs along with any error encountered.
+func (r *MemorySongRepository) FindPage(after string, limit int64) ([]model.Song, error) {
+	log.Printf("FindPage(%s, %d) \n", after, limit)
+	return r.findPage(false, after, limit)
+}
+
+// FindDeleted retrieves at most limit songs from the trash ordered by ID, starting after the song with the given ID.
+// An empty after value starts from the first song.
+// It returns a slice of songs along with any error encountered.
+func (r *MemorySongRepository) FindDeleted(after string, limit int64) ([]model.Song, error) {
+	log.Printf("FindDeleted(%s, %d) \n", after, limit)
+	return r.findPage(true, after, limit)
+}
+
+// findPage retrieves at most limit songs in or out of the trash ordered by ID, starting after the song with the given ID.
+func (r *MemorySongRepository) findPage(deleted bool, after string, limit int64) ([]model.Song, error) {
+	var afterID primitive.ObjectID
+	if after != "" {
+		oid, err := primitive.ObjectIDFromHex(after)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		afterID = oid
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var songs []model.Song
+	for _, song := range r.sorted(deleted) {
+		if int64(len(songs)) >= limit {
+			break
+		}
+		if after != "" && song.ID.Hex() <= afterID.Hex() {
+			continue
+		}
+		songs = append(songs, song)
+	}
+	return songs, nil
+}
+
+// FindByID retrieves a single song by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no song matches or the song is in the trash.
+func (r *MemorySongRepository) FindByID(id string) (model.Song, error) {
+	log.Printf("FindByID(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Song{}, ErrInvalidID
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	song, ok := r.songs[oid]
+	if !ok || song.DeletedAt != nil {
+		return model.Song{}, ErrNotFound
+	}
+	return song, nil
+}
+
+// Search retrieves at most limit songs matching the given filter.
+// A free-text query matches songs whose title, artist or album contains any of its words, ignoring case.
+// It returns a slice of songs along with any error encountered.
+func (r *MemorySongRepository) Search(f model.SongFilter, limit int64) ([]model.Song, error) {
+	log.Printf("Search(%v, %d) \n", f, limit)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var songs []model.Song
+	for _, song := range r.sorted(false) {
+		if int64(len(songs)) >= limit {
+			break
+		}
+		if matchesFilter(song, f) {
+			songs = append(songs, song)
+		}
+	}
+	return songs, nil
+}
+
+// Each calls fn for every song that is not in the trash and matches the filter, ordered by ID,
+// until fn returns an error or ctx is done.
+// The songs are read from a snapshot taken when the call starts.
+func (r *MemorySongRepository) Each(ctx context.Context, f model.SongFilter, fn func(model.Song) error) error {
+	log.Printf("Each(%v) \n", f)
+	r.mu.RLock()
+	songs := r.sorted(false)
+	r.mu.RUnlock()
+
+	for _, song := range songs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !matchesFilter(song, f) {
+			continue
+		}
+		if err := fn(song); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesFilter reports whether a song meets every criterion of the filter.
+func matchesFilter(song model.Song, f model.SongFilter) bool {
+	if terms := strings.Fields(strings.ToLower(f.Query)); len(terms) > 0 && !matchesAny(song, terms) {
+		return false
+	}
+	if f.Artist != "" && song.Artist != f.Artist {
+		return false
+	}
+	if f.Album != "" && song.Album != f.Album {
+		return false
+	}
+	if (f.MinDuration > 0 || f.MaxDuration > 0) && !inDurationRange(song.DurationMs, f) {
+		return false
+	}
+	return true
+}
+
+// Count returns the total number of songs that are not in the trash.
+func (r *MemorySongRepository) Count() (int64, error) {
+	log.Println("Count()")
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.sorted(false))), nil
+}
+
+// Update updates an existing song.
+// It takes a pointer to a model.Song as input and returns the song as stored after the update along with any error encountered.
+func (r *MemorySongRepository) Update(u *model.Song) (model.Song, error) {
+	log.Printf("Update(%v) \n", u)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.songs[u.ID]
+	if !ok || stored.DeletedAt != nil {
+		return model.Song{}, ErrNotFound
+	}
+	if stored.Version != u.Version {
+		return model.Song{}, ErrVersionConflict
+	}
+	song := *u
+	song.Version++
+	song.DeletedAt = nil
+	r.songs[u.ID] = song
+	return song, nil
+}
+
+// Delete moves a song to the trash by setting its deleted_at time.
+// It takes a string representing the song ID and the expected version as input and returns a boolean indicating the deletion success along with any error encountered.
+func (r *MemorySongRepository) Delete(id string, version int64) (bool, error) {
+	log.Printf("Delete(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		log.Printf("Invalid SongID(%s) \n", id)
+		return false, ErrInvalidID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.songs[oid]
+	if !ok || stored.DeletedAt != nil {
+		return false, ErrNotFound
+	}
+	if stored.Version != version {
+		return false, ErrVersionConflict
+	}
+	deletedAt := time.Now().UTC()
+	stored.DeletedAt = &deletedAt
+	stored.Version++
+	r.songs[oid] = stored
+	return true, nil
+}
+
+// Restore moves a song out of the trash.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if the song is not in the trash.
+func (r *MemorySongRepository) Restore(id string) (model.Song, error) {
+	log.Printf("Restore(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Song{}, ErrInvalidID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	song, ok := r.songs[oid]
+	if !ok || song.DeletedAt == nil {
+		return model.Song{}, ErrNotFound
+	}
+	song.DeletedAt = nil
+	song.Version++
+	r.songs[oid] = song
+	return song, nil
+}
+
+// Purge permanently deletes a song from the trash.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if the song is not in the trash.
+func (r *MemorySongRepository) Purge(id string) (bool, error) {
+	log.Printf("Purge(%s) \n", id)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, ErrInvalidID
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	song, ok := r.songs[oid]
+	if !ok || song.DeletedAt == nil {
+		return false, ErrNotFound
+	}
+	delete(r.songs, oid)
+	return true, nil
+}
+
+// PurgeDeletedBefore permanently deletes the songs that were moved to the trash before t.
+// It returns the IDs of the purged songs along with any error encountered.
+func (r *MemorySongRepository) PurgeDeletedBefore(t time.Time) ([]primitive.ObjectID, error) {
+	log.Printf("PurgeDeletedBefore(%v) \n", t)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var purged []primitive.ObjectID
+	for id, song := range r.songs {
+		if song.DeletedAt != nil && song.DeletedAt.Before(t) {
+			delete(r.songs, id)
+			purged = append(purged, id)
+		}
+	}
+	return purged, nil
+}
+
+// sorted returns the songs in or out of the trash ordered by ID, the caller must hold the lock.
+func (r *MemorySongRepository) sorted(deleted bool) []model.Song {
+	songs := make([]model.Song, 0, len(r.songs))
+	for _, song := range r.songs {
+		if (song.DeletedAt != nil) == deleted {
+			songs = append(songs, song)
+		}
+	}
+	sort.Slice(songs, func(i, j int) bool {
+		return songs[i].ID.Hex() < songs[j].ID.Hex()
+	})
+	return songs
+}
+
+// matchesAny reports whether the title, artist or album of the song contains any of the lower-case terms.
+func matchesAny(song model.Song, terms []string) bool {
+	text := strings.ToLower(song.Title + " " + song.Artist + " " + song.Album)
+	for _, term := range terms {
+		if strings.Contains(text, term) {
+			return true
+		}
+	}
+	return false
+}
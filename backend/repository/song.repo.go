@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -9,6 +12,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // SongRepository handles operations related to songs in the database.
@@ -25,6 +29,40 @@ func NewSongRepo(db *mongo.Database) *SongRepository {
 	}
 }
 
+// EnsureIndexes creates the indexes required by the repository.
+// It creates a text index on title, artist and album, an index on duration_ms used by Search
+// and an index on deleted_at used to find and purge the trash.
+func (r *SongRepository) EnsureIndexes() error {
+	log.Println("EnsureIndexes()")
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	_, err := r.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "title", Value: "text"},
+				{Key: "artist", Value: "text"},
+				{Key: "album", Value: "text"},
+			},
+			Options: options.Index().SetName("song_text"),
+		},
+		{
+			Keys:    bson.D{{Key: "duration_ms", Value: 1}},
+			Options: options.Index().SetName("song_duration_ms"),
+		},
+		{
+			Keys:    bson.D{{Key: "deleted_at", Value: 1}},
+			Options: options.Index().SetName("song_deleted_at"),
+		},
+	})
+	if err != nil {
+		log.Println(err)
+		return mongoError(err)
+	}
+
+	return nil
+}
+
 // Save inserts a new song into the database.
 // It takes a pointer to a model.Song as input and returns the saved song along with any error encountered.
 func (r *SongRepository) Save(u *model.Song) (model.Song, error) {
@@ -33,22 +71,71 @@ func (r *SongRepository) Save(u *model.Song) (model.Song, error) {
 	defer cancel()
 
 	var song model.Song
+	u.Version = 1
 	res, err := r.col.InsertOne(ctx, u)
 	if err != nil {
 		log.Println(err)
-		return song, err
+		return song, mongoError(err)
 	}
 
 	err = r.col.FindOne(ctx, bson.M{"_id": res.InsertedID}).Decode(&song)
 	if err != nil {
 		log.Println(err)
-		return song, err
+		return song, mongoError(err)
 	}
 
 	return song, nil
 }
 
-// FindAll retrieves all songs from the database.
+// SaveMany inserts a batch of new songs with a single unordered InsertMany, a song that cannot be inserted
+// does not prevent the others from being inserted.
+// It returns the inserted songs in batch order and the songs that failed.
+func (r *SongRepository) SaveMany(songs []*model.Song) ([]model.Song, []SaveFailure, error) {
+	log.Printf("SaveMany(%d songs) \n", len(songs))
+	if len(songs) == 0 {
+		return nil, nil, nil
+	}
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	docs := make([]interface{}, len(songs))
+	batch := make([]model.Song, len(songs))
+	for i, u := range songs {
+		batch[i] = *u
+		if batch[i].ID.IsZero() {
+			batch[i].ID = primitive.NewObjectID()
+		}
+		batch[i].Version = 1
+		batch[i].DeletedAt = nil
+		docs[i] = batch[i]
+	}
+
+	failed := map[int]error{}
+	_, err := r.col.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil {
+		var bulkErr mongo.BulkWriteException
+		if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+			log.Println(err)
+			return nil, nil, mongoError(err)
+		}
+		for _, we := range bulkErr.WriteErrors {
+			failed[we.Index] = mongoError(mongo.WriteException{WriteErrors: mongo.WriteErrors{we.WriteError}})
+		}
+	}
+
+	var saved []model.Song
+	var failures []SaveFailure
+	for i, song := range batch {
+		if err, ok := failed[i]; ok {
+			failures = append(failures, SaveFailure{Index: i, Err: err})
+			continue
+		}
+		saved = append(saved, song)
+	}
+	return saved, failures, nil
+}
+
+// FindAll retrieves all songs that are not in the trash from the database.
 // It returns a slice of songs along with any error encountered.
 func (r *SongRepository) FindAll() ([]model.Song, error) {
 	log.Println("FindAll()")
@@ -56,10 +143,10 @@ func (r *SongRepository) FindAll() ([]model.Song, error) {
 	defer cancel()
 
 	var songs []model.Song
-	cur, err := r.col.Find(ctx, bson.M{})
+	cur, err := r.col.Find(ctx, bson.M{"deleted_at": nil})
 	if err != nil {
 		log.Println(err)
-		return songs, err
+		return songs, mongoError(err)
 	}
 
 	defer cur.Close(ctx)
@@ -74,58 +161,493 @@ func (r *SongRepository) FindAll() ([]model.Song, error) {
 
 	if err := cur.Err(); err != nil {
 		log.Println(err)
-		return nil, err
+		return nil, mongoError(err)
+	}
+
+	return songs, nil
+}
+
+// FindPage retrieves at most limit songs ordered by ID, starting after the song with the given ID.
+// An empty after value starts from the first song.
+// It returns a slice of songs along with any error encountered.
+func (r *SongRepository) FindPage(after string, limit int64) ([]model.Song, error) {
+	log.Printf("FindPage(%s, %d) \n", after, limit)
+	return r.findPage(bson.M{"deleted_at": nil}, after, limit)
+}
+
+// FindDeleted retrieves at most limit songs from the trash ordered by ID, starting after the song with the given ID.
+// An empty after value starts from the first song.
+// It returns a slice of songs along with any error encountered.
+func (r *SongRepository) FindDeleted(after string, limit int64) ([]model.Song, error) {
+	log.Printf("FindDeleted(%s, %d) \n", after, limit)
+	return r.findPage(bson.M{"deleted_at": bson.M{"$ne": nil}}, after, limit)
+}
+
+// findPage retrieves at most limit songs matching filter ordered by ID, starting after the song with the given ID.
+func (r *SongRepository) findPage(filter bson.M, after string, limit int64) ([]model.Song, error) {
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	if after != "" {
+		oid, err := primitive.ObjectIDFromHex(after)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		filter["_id"] = bson.M{"$gt": oid}
+	}
+
+	var songs []model.Song
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		log.Println(err)
+		return songs, mongoError(err)
+	}
+
+	defer cur.Close(ctx)
+	if err := cur.All(ctx, &songs); err != nil {
+		log.Println(err)
+		return nil, mongoError(err)
 	}
 
 	return songs, nil
 }
 
+// Search retrieves at most limit songs matching the given filter.
+// Free-text queries use the text index and are ordered by relevance.
+// It returns a slice of songs along with any error encountered.
+func (r *SongRepository) Search(f model.SongFilter, limit int64) ([]model.Song, error) {
+	log.Printf("Search(%v, %d) \n", f, limit)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	filter := searchFilter(f)
+	opts := options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}).SetLimit(limit)
+	if f.Query != "" {
+		opts.SetProjection(bson.M{"score": bson.M{"$meta": "textScore"}})
+		opts.SetSort(bson.D{{Key: "score", Value: bson.M{"$meta": "textScore"}}, {Key: "_id", Value: 1}})
+	}
+
+	var songs []model.Song
+	cur, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		log.Println(err)
+		return songs, mongoError(err)
+	}
+
+	defer cur.Close(ctx)
+	if err := cur.All(ctx, &songs); err != nil {
+		log.Println(err)
+		return nil, mongoError(err)
+	}
+
+	return songs, nil
+}
+
+// Each calls fn for every song that is not in the trash and matches the filter, ordered by ID,
+// until fn returns an error or ctx is done.
+// The songs are decoded from the cursor one at a time instead of being loaded all at once like FindAll does.
+func (r *SongRepository) Each(ctx context.Context, f model.SongFilter, fn func(model.Song) error) error {
+	log.Printf("Each(%v) \n", f)
+
+	cur, err := r.col.Find(ctx, searchFilter(f), options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		log.Println(err)
+		return mongoError(err)
+	}
+	defer cur.Close(context.Background())
+
+	for cur.Next(ctx) {
+		var song model.Song
+		if err := cur.Decode(&song); err != nil {
+			log.Println(err)
+			return mongoError(err)
+		}
+		if err := fn(song); err != nil {
+			return err
+		}
+	}
+	return mongoError(cur.Err())
+}
+
+// searchFilter builds the query selecting the songs that are not in the trash and match the filter.
+func searchFilter(f model.SongFilter) bson.M {
+	filter := bson.M{"deleted_at": nil}
+	if f.Query != "" {
+		filter["$text"] = bson.M{"$search": f.Query}
+	}
+	if f.Artist != "" {
+		filter["artist"] = f.Artist
+	}
+	if f.Album != "" {
+		filter["album"] = f.Album
+	}
+	if f.MinDuration > 0 || f.MaxDuration > 0 {
+		durationMs := bson.M{}
+		if f.MinDuration > 0 {
+			durationMs["$gte"] = f.MinDuration.Milliseconds()
+		}
+		if f.MaxDuration > 0 {
+			durationMs["$lte"] = f.MaxDuration.Milliseconds()
+		}
+		filter["duration_ms"] = durationMs
+	}
+	return filter
+}
+
+// inDurationRange reports whether the duration lies within the range of the filter.
+func inDurationRange(durationMs int64, f model.SongFilter) bool {
+	if f.MinDuration > 0 && durationMs < f.MinDuration.Milliseconds() {
+		return false
+	}
+	if f.MaxDuration > 0 && durationMs > f.MaxDuration.Milliseconds() {
+		return false
+	}
+	return true
+}
+
+// Count returns the total number of songs in the database that are not in the trash.
+func (r *SongRepository) Count() (int64, error) {
+	log.Println("Count()")
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	total, err := r.col.CountDocuments(ctx, bson.M{"deleted_at": nil})
+	if err != nil {
+		log.Println(err)
+		return 0, mongoError(err)
+	}
+
+	return total, nil
+}
+
+// FindByID retrieves a single song from the database by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no song matches or the song is in the trash.
+func (r *SongRepository) FindByID(id string) (model.Song, error) {
+	log.Printf("FindByID(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var song model.Song
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return song, ErrInvalidID
+	}
+
+	err = r.col.FindOne(ctx, bson.M{"_id": oid, "deleted_at": nil}).Decode(&song)
+	if err != nil {
+		log.Println(err)
+		return song, mongoError(err)
+	}
+
+	return song, nil
+}
+
 // Update updates an existing song in the database.
-// It takes a pointer to a model.Song as input and returns the updated song along with any error encountered.
+// It takes a pointer to a model.Song as input and returns the song as stored after the update along with any error encountered.
 func (r *SongRepository) Update(u *model.Song) (model.Song, error) {
 	log.Printf("Update(%v) \n", u)
 	ctx, cancel := timeoutContext()
 	defer cancel()
 
-	filter := bson.M{"_id": u.ID}
+	filter := bson.M{"_id": u.ID, "version": u.Version, "deleted_at": nil}
 	update := bson.M{
+		"$inc": bson.M{"version": 1},
 		"$set": bson.M{
 			"title":       	u.Title,
 			"artist":	 	u.Artist,
 			"album":       	u.Album,
 			"duration": 	u.Duration,
+			"duration_ms": 	u.DurationMs,
 			"link": 		u.Link,
 		},
 	}
 
 	var song model.Song
-	err := r.col.FindOneAndUpdate(ctx, filter, update).Decode(&song)
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&song)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = r.versionError(ctx, u.ID)
+	}
 	if err != nil {
 		log.Printf("ERR 115 %v", err)
-		return song, err
+		return song, mongoError(err)
 	}
 
 	return song, nil
 }
 
-// Delete deletes a song from the database by its ID.
-// It takes a string representing the song ID as input and returns a boolean indicating the deletion success along with any error encountered.
-func (r *SongRepository) Delete(id string) (bool, error) {
+// Delete moves a song to the trash by setting its deleted_at time.
+// It takes a string representing the song ID and the expected version as input and returns a boolean indicating the deletion success along with any error encountered.
+func (r *SongRepository) Delete(id string, version int64) (bool, error) {
 	log.Printf("Delete(%s) \n", id)
 	ctx, cancel := timeoutContext()
 	defer cancel()
 
 	var song model.Song
-	oid, _ := primitive.ObjectIDFromHex(id)
-	err := r.col.FindOneAndDelete(ctx, bson.M{"_id": oid}).Decode(&song)
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		log.Printf("Invalid SongID(%s) \n", id)
+		return false, ErrInvalidID
+	}
+	filter := bson.M{"_id": oid, "version": version, "deleted_at": nil}
+	update := bson.M{
+		"$inc": bson.M{"version": 1},
+		"$set": bson.M{"deleted_at": time.Now().UTC()},
+	}
+	err = r.col.FindOneAndUpdate(ctx, filter, update).Decode(&song)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		err = r.versionError(ctx, oid)
+	}
 	if err != nil {
 		log.Printf("Fail to delete song: %v \n", err)
-		return false, err
+		return false, mongoError(err)
 	}
 	log.Printf("Deleted_song(%v) \n", song)
 	return true, nil
 }
 
+// Restore moves a song out of the trash.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if the song is not in the trash.
+func (r *SongRepository) Restore(id string) (model.Song, error) {
+	log.Printf("Restore(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var song model.Song
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return song, ErrInvalidID
+	}
+
+	filter := bson.M{"_id": oid, "deleted_at": bson.M{"$ne": nil}}
+	update := bson.M{
+		"$inc":   bson.M{"version": 1},
+		"$unset": bson.M{"deleted_at": ""},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err = r.col.FindOneAndUpdate(ctx, filter, update, opts).Decode(&song)
+	if err != nil {
+		log.Println(err)
+		return song, mongoError(err)
+	}
+
+	return song, nil
+}
+
+// Purge permanently deletes a song from the trash.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if the song is not in the trash.
+func (r *SongRepository) Purge(id string) (bool, error) {
+	log.Printf("Purge(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, ErrInvalidID
+	}
+
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": oid, "deleted_at": bson.M{"$ne": nil}})
+	if err != nil {
+		log.Printf("Fail to purge song: %v \n", err)
+		return false, mongoError(err)
+	}
+	if res.DeletedCount == 0 {
+		return false, ErrNotFound
+	}
+	return true, nil
+}
+
+// PurgeDeletedBefore permanently deletes the songs that were moved to the trash before t.
+// It returns the IDs of the purged songs along with any error encountered.
+func (r *SongRepository) PurgeDeletedBefore(t time.Time) ([]primitive.ObjectID, error) {
+	log.Printf("PurgeDeletedBefore(%v) \n", t)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	// Find the songs to purge first, MongoDB does not return the documents removed by DeleteMany.
+	filter := bson.M{"deleted_at": bson.M{"$lt": t}}
+	cursor, err := r.col.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		log.Printf("Fail to find songs to purge: %v \n", err)
+		return nil, mongoError(err)
+	}
+	var docs []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(ctx, &docs); err != nil {
+		log.Printf("Fail to find songs to purge: %v \n", err)
+		return nil, mongoError(err)
+	}
+	if len(docs) == 0 {
+		return nil, nil
+	}
+	purged := make([]primitive.ObjectID, len(docs))
+	for i, doc := range docs {
+		purged[i] = doc.ID
+	}
+
+	// Songs restored in the meantime no longer match the filter and are kept.
+	filter["_id"] = bson.M{"$in": purged}
+	_, err = r.col.DeleteMany(ctx, filter)
+	if err != nil {
+		log.Printf("Fail to purge songs: %v \n", err)
+		return nil, mongoError(err)
+	}
+	return purged, nil
+}
+
+// Watch calls fn for every song created, updated, deleted or restored after the change identified by resumeToken,
+// or after the call when resumeToken is empty, until ctx is done or fn returns an error.
+// It reads the changes from a MongoDB change stream, resume tokens are the encoded tokens of the stream.
+func (r *SongRepository) Watch(ctx context.Context, resumeToken string, fn func(model.SongEvent) error) error {
+	log.Printf("Watch(%s) \n", resumeToken)
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(resumeToken)
+		if err != nil || bson.Raw(raw).Validate() != nil {
+			return ErrInvalidResumeToken
+		}
+		opts.SetResumeAfter(bson.Raw(raw))
+	}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"operationType": bson.M{"$in": []string{"insert", "update", "replace"}}}}},
+	}
+
+	cs, err := r.col.Watch(ctx, pipeline, opts)
+	if err != nil {
+		log.Println(err)
+		return changeStreamError(err)
+	}
+	defer cs.Close(context.Background())
+
+	for cs.Next(ctx) {
+		var change struct {
+			OperationType     string              `bson:"operationType"`
+			FullDocument      *model.Song         `bson:"fullDocument"`
+			ClusterTime       primitive.Timestamp `bson:"clusterTime"`
+			UpdateDescription struct {
+				UpdatedFields bson.M   `bson:"updatedFields"`
+				RemovedFields []string `bson:"removedFields"`
+			} `bson:"updateDescription"`
+		}
+		if err := cs.Decode(&change); err != nil {
+			log.Println(err)
+			return err
+		}
+		// The song was purged before its current state could be looked up.
+		if change.FullDocument == nil {
+			continue
+		}
+
+		event := model.SongEvent{
+			Type:        model.SongUpdated,
+			Song:        *change.FullDocument,
+			ResumeToken: base64.RawURLEncoding.EncodeToString(cs.ResumeToken()),
+			Time:        time.Unix(int64(change.ClusterTime.T), 0).UTC(),
+		}
+		_, trashed := change.UpdateDescription.UpdatedFields["deleted_at"]
+		switch {
+		case change.OperationType == "insert":
+			event.Type = model.SongCreated
+		case trashed:
+			event.Type = model.SongDeleted
+		case contains(change.UpdateDescription.RemovedFields, "deleted_at"):
+			event.Type = model.SongCreated
+		case change.FullDocument.DeletedAt != nil:
+			// Changes to songs in the trash are not visible to watchers.
+			continue
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return changeStreamError(cs.Err())
+}
+
+// SupportsChangeStreams reports whether the database can open change streams, which requires a replica set.
+func (r *SongRepository) SupportsChangeStreams() bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cs, err := r.col.Watch(ctx, mongo.Pipeline{})
+	if err != nil {
+		log.Printf("Change streams are not available: %v \n", err)
+		return false
+	}
+	cs.Close(ctx)
+	return true
+}
+
+// changeStreamError converts change stream errors into the errors of the repository package.
+func changeStreamError(err error) error {
+	var cmdErr mongo.CommandError
+	if errors.As(err, &cmdErr) && cmdErr.Code == changeStreamHistoryLost {
+		return fmt.Errorf("%w: %v", ErrResumeTokenExpired, err)
+	}
+	return mongoError(err)
+}
+
+// changeStreamHistoryLost is the MongoDB error code returned when resuming a change stream after its oplog entry was removed.
+const changeStreamHistoryLost = 286
+
+// contains reports whether the list holds the value.
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// versionError tells why a write filtered on the ID and version of a song matched no document.
+// It returns ErrNotFound when the song does not exist and ErrVersionConflict when it has another version.
+func (r *SongRepository) versionError(ctx context.Context, id primitive.ObjectID) error {
+	n, err := r.col.CountDocuments(ctx, bson.M{"_id": id, "deleted_at": nil})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return ErrVersionConflict
+}
+
+// EnsureVersions sets version 1 on the songs stored before songs were versioned.
+// It returns the number of songs that were changed along with any error encountered.
+func (r *SongRepository) EnsureVersions() (int64, error) {
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	res, err := r.col.UpdateMany(ctx, bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": 1}})
+	if err != nil {
+		log.Println(err)
+		return 0, mongoError(err)
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// mongoError converts driver errors into the errors of the repository package.
+// Timeouts are reported as context.DeadlineExceeded.
+func mongoError(err error) error {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		return ErrNotFound
+	case mongo.IsDuplicateKeyError(err):
+		return fmt.Errorf("%w: %v", ErrAlreadyExists, err)
+	case mongo.IsTimeout(err) && !errors.Is(err, context.DeadlineExceeded):
+		return fmt.Errorf("%w: %v", context.DeadlineExceeded, err)
+	}
+	return err
+}
+
 // timeoutContext creates a context with a timeout of 60 seconds.
 func timeoutContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), time.Duration(60)*time.Second)
@@ -0,0 +1,505 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// songColumns lists the columns of the song table in the order scanned by scanSong.
+const songColumns = "id, title, artist, album, duration, duration_ms, link, version, deleted_at"
+
+// likeEscaper escapes the wildcard characters of a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// eachBatchSize is the number of songs read at once by Each.
+const eachBatchSize = 500
+
+// SQLiteSongRepository handles operations related to songs in a SQLite database.
+// Song IDs are generated as ObjectIDs and stored as hex strings, so they look the same as with MongoDB.
+type SQLiteSongRepository struct {
+	db *sql.DB
+}
+
+// NewSQLiteSongRepo creates a new instance of SQLiteSongRepository.
+// The database is expected to be opened with OpenSQLite.
+func NewSQLiteSongRepo(db *sql.DB) *SQLiteSongRepository {
+	return &SQLiteSongRepository{db: db}
+}
+
+// Save inserts a new song into the database.
+// It takes a pointer to a model.Song as input and returns the saved song along with any error encountered.
+func (r *SQLiteSongRepository) Save(u *model.Song) (model.Song, error) {
+	log.Printf("Save(%v) \n", u)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	song := *u
+	if song.ID.IsZero() {
+		song.ID = primitive.NewObjectID()
+	}
+	song.Version = 1
+	song.DeletedAt = nil
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO song ("+songColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL)",
+		song.ID.Hex(), song.Title, song.Artist, song.Album, song.Duration, song.DurationMs, song.Link, song.Version)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+
+	return song, nil
+}
+
+// SaveMany stores a batch of new songs in a single transaction, a song that cannot be stored does not prevent
+// the others from being stored.
+// It returns the stored songs in batch order and the songs that failed.
+func (r *SQLiteSongRepository) SaveMany(songs []*model.Song) ([]model.Song, []SaveFailure, error) {
+	log.Printf("SaveMany(%d songs) \n", len(songs))
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, sqliteError(err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, "INSERT INTO song ("+songColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL)")
+	if err != nil {
+		log.Println(err)
+		return nil, nil, sqliteError(err)
+	}
+	defer stmt.Close()
+
+	var saved []model.Song
+	var failures []SaveFailure
+	for i, u := range songs {
+		song := *u
+		if song.ID.IsZero() {
+			song.ID = primitive.NewObjectID()
+		}
+		song.Version = 1
+		song.DeletedAt = nil
+		// A failing statement is rolled back on its own, the rest of the transaction is kept.
+		_, err := stmt.ExecContext(ctx,
+			song.ID.Hex(), song.Title, song.Artist, song.Album, song.Duration, song.DurationMs, song.Link, song.Version)
+		if err != nil {
+			failures = append(failures, SaveFailure{Index: i, Err: sqliteError(err)})
+			continue
+		}
+		saved = append(saved, song)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return nil, nil, sqliteError(err)
+	}
+	return saved, failures, nil
+}
+
+// FindAll retrieves all songs that are not in the trash from the database ordered by ID.
+// It returns a slice of songs along with any error encountered.
+func (r *SQLiteSongRepository) FindAll() ([]model.Song, error) {
+	log.Println("FindAll()")
+	return r.query("SELECT " + songColumns + " FROM song WHERE deleted_at IS NULL ORDER BY id")
+}
+
+// FindPage retrieves at most limit songs ordered by ID, starting after the song with the given ID.
+// An empty after value starts from the first song.
+// It returns a slice of songs along with any error encountered.
+func (r *SQLiteSongRepository) FindPage(after string, limit int64) ([]model.Song, error) {
+	log.Printf("FindPage(%s, %d) \n", after, limit)
+	return r.findPage("deleted_at IS NULL", after, limit)
+}
+
+// FindDeleted retrieves at most limit songs from the trash ordered by ID, starting after the song with the given ID.
+// An empty after value starts from the first song.
+// It returns a slice of songs along with any error encountered.
+func (r *SQLiteSongRepository) FindDeleted(after string, limit int64) ([]model.Song, error) {
+	log.Printf("FindDeleted(%s, %d) \n", after, limit)
+	return r.findPage("deleted_at IS NOT NULL", after, limit)
+}
+
+// findPage retrieves at most limit songs matching the where clause ordered by ID, starting after the song with the given ID.
+func (r *SQLiteSongRepository) findPage(where string, after string, limit int64) ([]model.Song, error) {
+	if after != "" {
+		oid, err := primitive.ObjectIDFromHex(after)
+		if err != nil {
+			return nil, ErrInvalidID
+		}
+		after = oid.Hex()
+	}
+	return r.query("SELECT "+songColumns+" FROM song WHERE "+where+" AND id > ? ORDER BY id LIMIT ?", after, limit)
+}
+
+// FindByID retrieves a single song from the database by its ID.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if no song matches or the song is in the trash.
+func (r *SQLiteSongRepository) FindByID(id string) (model.Song, error) {
+	log.Printf("FindByID(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Song{}, ErrInvalidID
+	}
+
+	row := r.db.QueryRowContext(ctx, "SELECT "+songColumns+" FROM song WHERE id = ? AND deleted_at IS NULL", oid.Hex())
+	song, err := scanSong(row)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+
+	return song, nil
+}
+
+// Search retrieves at most limit songs matching the given filter.
+// A free-text query matches songs whose title, artist or album contains any of its words, ignoring case.
+// It returns a slice of songs along with any error encountered.
+func (r *SQLiteSongRepository) Search(f model.SongFilter, limit int64) ([]model.Song, error) {
+	log.Printf("Search(%v, %d) \n", f, limit)
+
+	where, args := searchWhere(f)
+	query := "SELECT " + songColumns + " FROM song WHERE " + where + " ORDER BY id LIMIT ?"
+	args = append(args, limit)
+
+	return r.query(query, args...)
+}
+
+// Each calls fn for every song that is not in the trash and matches the filter, ordered by ID,
+// until fn returns an error or ctx is done.
+// The songs are read in batches of eachBatchSize following the last ID of the previous batch,
+// and the rows of a batch are closed before fn is called, so a slow caller does not hold the only connection
+// of the database.
+func (r *SQLiteSongRepository) Each(ctx context.Context, f model.SongFilter, fn func(model.Song) error) error {
+	log.Printf("Each(%v) \n", f)
+
+	where, args := searchWhere(f)
+	query := "SELECT " + songColumns + " FROM song WHERE " + where + " AND id > ? ORDER BY id LIMIT ?"
+	after := ""
+	for {
+		songs, err := r.queryContext(ctx, query, append(args, after, eachBatchSize)...)
+		if err != nil {
+			return err
+		}
+		for _, song := range songs {
+			if err := fn(song); err != nil {
+				return err
+			}
+		}
+		if len(songs) < eachBatchSize {
+			return nil
+		}
+		after = songs[len(songs)-1].ID.Hex()
+	}
+}
+
+// searchWhere builds the WHERE clause, and its arguments, selecting the songs that are not in the trash and match the filter.
+func searchWhere(f model.SongFilter) (string, []interface{}) {
+	where := []string{"deleted_at IS NULL"}
+	var args []interface{}
+	if terms := strings.Fields(f.Query); len(terms) > 0 {
+		var clauses []string
+		for _, term := range terms {
+			clauses = append(clauses, `(title LIKE ? ESCAPE '\' OR artist LIKE ? ESCAPE '\' OR album LIKE ? ESCAPE '\')`)
+			pattern := "%" + likeEscaper.Replace(term) + "%"
+			args = append(args, pattern, pattern, pattern)
+		}
+		where = append(where, "("+strings.Join(clauses, " OR ")+")")
+	}
+	if f.Artist != "" {
+		where = append(where, "artist = ?")
+		args = append(args, f.Artist)
+	}
+	if f.Album != "" {
+		where = append(where, "album = ?")
+		args = append(args, f.Album)
+	}
+	if f.MinDuration > 0 {
+		where = append(where, "duration_ms >= ?")
+		args = append(args, f.MinDuration.Milliseconds())
+	}
+	if f.MaxDuration > 0 {
+		where = append(where, "duration_ms <= ?")
+		args = append(args, f.MaxDuration.Milliseconds())
+	}
+	return strings.Join(where, " AND "), args
+}
+
+// Count returns the total number of songs in the database that are not in the trash.
+func (r *SQLiteSongRepository) Count() (int64, error) {
+	log.Println("Count()")
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var total int64
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM song WHERE deleted_at IS NULL").Scan(&total)
+	if err != nil {
+		log.Println(err)
+		return 0, sqliteError(err)
+	}
+
+	return total, nil
+}
+
+// Update updates an existing song in the database.
+// It takes a pointer to a model.Song as input and returns the song as stored after the update along with any error encountered.
+func (r *SQLiteSongRepository) Update(u *model.Song) (model.Song, error) {
+	log.Printf("Update(%v) \n", u)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE song SET title = ?, artist = ?, album = ?, duration = ?, duration_ms = ?, link = ?, version = version + 1 WHERE id = ? AND version = ? AND deleted_at IS NULL",
+		u.Title, u.Artist, u.Album, u.Duration, u.DurationMs, u.Link, u.ID.Hex(), u.Version)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return model.Song{}, versionError(ctx, tx, u.ID.Hex())
+	}
+
+	row := tx.QueryRowContext(ctx, "SELECT "+songColumns+" FROM song WHERE id = ?", u.ID.Hex())
+	song, err := scanSong(row)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+	return song, nil
+}
+
+// Delete moves a song to the trash by setting its deleted_at time.
+// It takes a string representing the song ID and the expected version as input and returns a boolean indicating the deletion success along with any error encountered.
+func (r *SQLiteSongRepository) Delete(id string, version int64) (bool, error) {
+	log.Printf("Delete(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		log.Printf("Invalid SongID(%s) \n", id)
+		return false, ErrInvalidID
+	}
+
+	res, err := r.db.ExecContext(ctx,
+		"UPDATE song SET deleted_at = ?, version = version + 1 WHERE id = ? AND version = ? AND deleted_at IS NULL",
+		time.Now().UnixMilli(), oid.Hex(), version)
+	if err != nil {
+		log.Printf("Fail to delete song: %v \n", err)
+		return false, sqliteError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		err = versionError(ctx, r.db, oid.Hex())
+		log.Printf("Fail to delete song: %v \n", err)
+		return false, err
+	}
+	return true, nil
+}
+
+// Restore moves a song out of the trash.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if the song is not in the trash.
+func (r *SQLiteSongRepository) Restore(id string) (model.Song, error) {
+	log.Printf("Restore(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return model.Song{}, ErrInvalidID
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		"UPDATE song SET deleted_at = NULL, version = version + 1 WHERE id = ? AND deleted_at IS NOT NULL", oid.Hex())
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return model.Song{}, ErrNotFound
+	}
+
+	row := tx.QueryRowContext(ctx, "SELECT "+songColumns+" FROM song WHERE id = ?", oid.Hex())
+	song, err := scanSong(row)
+	if err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Println(err)
+		return model.Song{}, sqliteError(err)
+	}
+	return song, nil
+}
+
+// Purge permanently deletes a song from the trash.
+// It returns ErrInvalidID if the ID is malformed and ErrNotFound if the song is not in the trash.
+func (r *SQLiteSongRepository) Purge(id string) (bool, error) {
+	log.Printf("Purge(%s) \n", id)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return false, ErrInvalidID
+	}
+
+	res, err := r.db.ExecContext(ctx, "DELETE FROM song WHERE id = ? AND deleted_at IS NOT NULL", oid.Hex())
+	if err != nil {
+		log.Printf("Fail to purge song: %v \n", err)
+		return false, sqliteError(err)
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		return false, ErrNotFound
+	}
+	return true, nil
+}
+
+// PurgeDeletedBefore permanently deletes the songs that were moved to the trash before t.
+// It returns the IDs of the purged songs along with any error encountered.
+func (r *SQLiteSongRepository) PurgeDeletedBefore(t time.Time) ([]primitive.ObjectID, error) {
+	log.Printf("PurgeDeletedBefore(%v) \n", t)
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	rows, err := r.db.QueryContext(ctx, "DELETE FROM song WHERE deleted_at < ? RETURNING id", t.UnixMilli())
+	if err != nil {
+		log.Printf("Fail to purge songs: %v \n", err)
+		return nil, sqliteError(err)
+	}
+	defer rows.Close()
+
+	var purged []primitive.ObjectID
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, sqliteError(err)
+		}
+		oid, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, err
+		}
+		purged = append(purged, oid)
+	}
+	return purged, sqliteError(rows.Err())
+}
+
+// query runs a SELECT statement returning song rows.
+func (r *SQLiteSongRepository) query(query string, args ...interface{}) ([]model.Song, error) {
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	return r.queryContext(ctx, query, args...)
+}
+
+// queryContext runs a SELECT statement returning song rows within ctx.
+func (r *SQLiteSongRepository) queryContext(ctx context.Context, query string, args ...interface{}) ([]model.Song, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, sqliteError(err)
+	}
+	defer rows.Close()
+
+	var songs []model.Song
+	for rows.Next() {
+		song, err := scanSong(rows)
+		if err != nil {
+			log.Println(err)
+			return nil, sqliteError(err)
+		}
+		songs = append(songs, song)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Println(err)
+		return nil, sqliteError(err)
+	}
+
+	return songs, nil
+}
+
+// scanner is implemented by *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// querier is implemented by *sql.DB and *sql.Tx.
+type querier interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// scanSong reads a song from a row holding songColumns.
+func scanSong(row scanner) (model.Song, error) {
+	var song model.Song
+	var id string
+	var deletedAt sql.NullInt64
+	err := row.Scan(&id, &song.Title, &song.Artist, &song.Album, &song.Duration, &song.DurationMs, &song.Link, &song.Version, &deletedAt)
+	if err != nil {
+		return song, err
+	}
+	if deletedAt.Valid {
+		t := time.UnixMilli(deletedAt.Int64).UTC()
+		song.DeletedAt = &t
+	}
+	song.ID, err = primitive.ObjectIDFromHex(id)
+	return song, err
+}
+
+// versionError tells why a statement filtered on the ID and version of a song affected no row.
+// It returns ErrNotFound when the song does not exist and ErrVersionConflict when it has another version.
+func versionError(ctx context.Context, q querier, id string) error {
+	var n int
+	if err := q.QueryRowContext(ctx, "SELECT COUNT(*) FROM song WHERE id = ? AND deleted_at IS NULL", id).Scan(&n); err != nil {
+		return sqliteError(err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return ErrVersionConflict
+}
+
+// sqliteError converts database/sql errors into the errors of the repository package.
+func sqliteError(err error) error {
+	var sqliteErr *sqlite.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return ErrNotFound
+	case errors.As(err, &sqliteErr) &&
+		(sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY || sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE):
+		return fmt.Errorf("%w: %v", ErrAlreadyExists, err)
+	}
+	return err
+}
@@ -0,0 +1,20 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"github.com/Dwiyasa-Nakula/master/backend/repository/storetest"
+)
+
+func TestMemorySongStore(t *testing.T) {
+	storetest.TestSongStore(t, func(t *testing.T) repository.SongStore {
+		return repository.NewMemorySongRepo()
+	})
+}
+
+func TestMemoryAuditStore(t *testing.T) {
+	storetest.TestAuditStore(t, func(t *testing.T) repository.AuditStore {
+		return repository.NewMemoryAuditRepo()
+	})
+}
@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"github.com/Dwiyasa-Nakula/master/backend/repository/storetest"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// mongoURIEnv is the environment variable holding the URI of the MongoDB server used by the tests.
+// The MongoDB tests are skipped when it is not set.
+const mongoURIEnv = "MUSICPLAYLIST_TEST_MONGODB_URI"
+
+// openTestMongo returns an empty database on the MongoDB server at MUSICPLAYLIST_TEST_MONGODB_URI,
+// dropped after the test.
+func openTestMongo(t *testing.T) *mongo.Database {
+	uri := os.Getenv(mongoURIEnv)
+	if uri == "" {
+		t.Skipf("%s not set", mongoURIEnv)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := client.Database(fmt.Sprintf("musicplaylist_test_%d", time.Now().UnixNano()))
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		db.Drop(ctx)
+		client.Disconnect(ctx)
+	})
+	return db
+}
+
+func TestMongoSongStore(t *testing.T) {
+	if os.Getenv(mongoURIEnv) == "" {
+		t.Skipf("%s not set", mongoURIEnv)
+	}
+	storetest.TestSongStore(t, func(t *testing.T) repository.SongStore {
+		return repository.NewSongRepo(openTestMongo(t))
+	})
+}
+
+func TestMongoAuditStore(t *testing.T) {
+	if os.Getenv(mongoURIEnv) == "" {
+		t.Skipf("%s not set", mongoURIEnv)
+	}
+	storetest.TestAuditStore(t, func(t *testing.T) repository.AuditStore {
+		return repository.NewAuditRepo(openTestMongo(t))
+	})
+}
@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"github.com/Dwiyasa-Nakula/master/backend/repository/storetest"
+)
+
+// openTestSQLite opens an empty SQLite database in a temporary directory removed after the test.
+func openTestSQLite(t *testing.T) *sql.DB {
+	db, err := repository.OpenSQLite(filepath.Join(t.TempDir(), "musicplaylist.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestSQLiteSongStore(t *testing.T) {
+	storetest.TestSongStore(t, func(t *testing.T) repository.SongStore {
+		return repository.NewSQLiteSongRepo(openTestSQLite(t))
+	})
+}
+
+func TestSQLiteAuditStore(t *testing.T) {
+	storetest.TestAuditStore(t, func(t *testing.T) repository.AuditStore {
+		return repository.NewSQLiteAuditRepo(openTestSQLite(t))
+	})
+}
+
+// TestSQLiteEachBatches checks that Each reads songs over several batches and lets fn use the store,
+// which needs the only connection of the database while Each is running.
+func TestSQLiteEachBatches(t *testing.T) {
+	store := repository.NewSQLiteSongRepo(openTestSQLite(t))
+	const total = 1234
+	batch := make([]*model.Song, total)
+	for i := range batch {
+		batch[i] = &model.Song{Title: fmt.Sprintf("Song %d", i), Artist: "Artist", Duration: "3:00"}
+	}
+	if _, failed, err := store.SaveMany(batch); err != nil || len(failed) > 0 {
+		t.Fatalf("SaveMany: %v, %d failed", err, len(failed))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	var last string
+	calls := 0
+	err := store.Each(ctx, model.SongFilter{Artist: "Artist"}, func(song model.Song) error {
+		if song.ID.Hex() <= last {
+			return fmt.Errorf("song %s after %s", song.ID.Hex(), last)
+		}
+		last = song.ID.Hex()
+		calls++
+		_, err := store.FindByID(song.ID.Hex())
+		return err
+	})
+	if err != nil {
+		t.Fatalf("Each: %v", err)
+	}
+	if calls != total {
+		t.Fatalf("Each called fn %d times, want %d", calls, total)
+	}
+}
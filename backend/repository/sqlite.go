@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+
+	_ "modernc.org/sqlite" // Registers the "sqlite" database/sql driver
+)
+
+// sqliteMigrations holds the schema changes of the SQLite database.
+// Migration i brings the schema from version i to version i+1, applied versions are tracked in PRAGMA user_version.
+// Never edit an applied migration, append a new one instead.
+var sqliteMigrations = []string{
+	`CREATE TABLE song (
+		id       TEXT PRIMARY KEY,
+		title    TEXT NOT NULL,
+		artist   TEXT NOT NULL,
+		album    TEXT NOT NULL,
+		duration TEXT NOT NULL,
+		link     TEXT NOT NULL
+	);
+	CREATE INDEX song_artist ON song (artist);
+	CREATE INDEX song_album ON song (album);
+	CREATE TABLE playlist (
+		id          TEXT PRIMARY KEY,
+		name        TEXT NOT NULL,
+		description TEXT NOT NULL,
+		owner       TEXT NOT NULL,
+		song_ids    TEXT NOT NULL,
+		created_at  INTEGER NOT NULL,
+		updated_at  INTEGER NOT NULL
+	);
+	CREATE INDEX playlist_owner ON playlist (owner);`,
+	`ALTER TABLE song ADD COLUMN duration_ms INTEGER NOT NULL DEFAULT 0;
+	CREATE INDEX song_duration_ms ON song (duration_ms);`,
+	`ALTER TABLE song ADD COLUMN version INTEGER NOT NULL DEFAULT 1;`,
+	`ALTER TABLE song ADD COLUMN deleted_at INTEGER;
+	CREATE INDEX song_deleted_at ON song (deleted_at);`,
+	`CREATE TABLE song_audit (
+		id      TEXT PRIMARY KEY,
+		song_id TEXT NOT NULL,
+		actor   TEXT NOT NULL,
+		rpc     TEXT NOT NULL,
+		time    INTEGER NOT NULL,
+		before  TEXT,
+		after   TEXT
+	);
+	CREATE INDEX song_audit_song_id ON song_audit (song_id, id);`,
+}
+
+// OpenSQLite opens the SQLite database at path and migrates its schema to the latest version.
+func OpenSQLite(path string) (*sql.DB, error) {
+	log.Printf("OpenSQLite(%s) \n", path)
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite allows a single writer, serialize access through one connection
+	db.SetMaxOpenConns(1)
+
+	if err := migrateSQLite(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// migrateSQLite applies the migrations the database has not seen yet, each in its own transaction.
+func migrateSQLite(db *sql.DB) error {
+	ctx, cancel := timeoutContext()
+	defer cancel()
+
+	var version int
+	if err := db.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return err
+	}
+
+	for ; version < len(sqliteMigrations); version++ {
+		log.Printf("Migrating SQLite schema to version %d \n", version+1)
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, sqliteMigrations[version]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", version+1, err)
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version+1)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
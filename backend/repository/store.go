@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	// ErrInvalidID is returned when an ID is not a valid ObjectID hex string.
+	ErrInvalidID = errors.New("invalid id")
+	// ErrNotFound is returned when no document matches the given ID.
+	ErrNotFound = errors.New("not found")
+	// ErrAlreadyExists is returned when a document with the same ID or unique key is already stored.
+	ErrAlreadyExists = errors.New("already exists")
+	// ErrInvalidPosition is returned when a track position lies outside of the playlist.
+	ErrInvalidPosition = errors.New("invalid track position")
+	// ErrConcurrentUpdate is returned when the tracks of a playlist changed while being reordered.
+	ErrConcurrentUpdate = errors.New("playlist was changed concurrently")
+	// ErrVersionConflict is returned when a song is updated or deleted with a version that is no longer the stored one.
+	ErrVersionConflict = errors.New("song was changed concurrently")
+)
+
+// SaveFailure reports a song of a batch passed to SaveMany that could not be stored.
+type SaveFailure struct {
+	Index int   // Position of the song in the batch
+	Err   error // Reason the song was not stored
+}
+
+// SongStore is the storage used by the song service.
+// SongRepository implements it on top of MongoDB and MemorySongRepository in memory.
+// Saved songs start at version 1, Update and Delete only succeed for the stored version and Update increments it.
+// Delete moves a song to the trash, trashed songs are only returned by FindDeleted until they are restored or purged.
+// SaveMany stores the songs it can and reports the others as failures, it only returns an error when the whole batch failed.
+// Each streams the songs matching a filter ordered by ID without loading them all at once.
+type SongStore interface {
+	Save(u *model.Song) (model.Song, error)
+	SaveMany(songs []*model.Song) ([]model.Song, []SaveFailure, error)
+	FindAll() ([]model.Song, error)
+	FindPage(after string, limit int64) ([]model.Song, error)
+	FindByID(id string) (model.Song, error)
+	Search(f model.SongFilter, limit int64) ([]model.Song, error)
+	Each(ctx context.Context, f model.SongFilter, fn func(model.Song) error) error
+	Count() (int64, error)
+	Update(u *model.Song) (model.Song, error)
+	Delete(id string, version int64) (bool, error)
+	FindDeleted(after string, limit int64) ([]model.Song, error)
+	Restore(id string) (model.Song, error)
+	Purge(id string) (bool, error)
+	PurgeDeletedBefore(t time.Time) ([]primitive.ObjectID, error)
+}
+
+// AuditStore is the storage of the audit trail of songs.
+// AuditRepository implements it on top of MongoDB and MemoryAuditRepository in memory.
+// FindBySong returns the entries of a song newest first.
+type AuditStore interface {
+	Record(a *model.SongAudit) (model.SongAudit, error)
+	FindBySong(songID string) ([]model.SongAudit, error)
+	FindByID(id string) (model.SongAudit, error)
+}
+
+// PlaylistStore is the storage used by the playlist service.
+// PlaylistRepository implements it on top of MongoDB and MemoryPlaylistRepository in memory.
+type PlaylistStore interface {
+	Save(p *model.Playlist) (model.Playlist, error)
+	FindByID(id string) (model.Playlist, error)
+	FindAll(owner string) ([]model.Playlist, error)
+	Rename(id, name, description string) (model.Playlist, error)
+	AddTrack(id string, songID primitive.ObjectID) (model.Playlist, error)
+	RemoveTrack(id string, position int) (model.Playlist, error)
+	MoveTrack(id string, from, to int) (model.Playlist, error)
+	Delete(id string) (bool, error)
+}
@@ -0,0 +1,352 @@
+// Package storetest implements a conformance suite for the storage backends of the repository package.
+//
+// Every backend must pass it, so the song service behaves the same whichever driver is configured:
+//
+//	func TestMemorySongStore(t *testing.T) {
+//		storetest.TestSongStore(t, func(t *testing.T) repository.SongStore {
+//			return repository.NewMemorySongRepo()
+//		})
+//	}
+package storetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TestSongStore runs the conformance suite against the SongStore returned by newStore.
+// newStore is called once per subtest and must return an empty store.
+func TestSongStore(t *testing.T, newStore func(t *testing.T) repository.SongStore) {
+	t.Run("SaveAssignsID", func(t *testing.T) {
+		store := newStore(t)
+		song := mustSave(t, store, "Title", "Artist")
+		if song.ID.IsZero() {
+			t.Fatal("Save returned a song without ID")
+		}
+		if song.Title != "Title" || song.Artist != "Artist" || song.Album != "Album" || song.Duration != "3:45" || song.Link != "123" {
+			t.Fatalf("Save returned %+v, fields do not match the input", song)
+		}
+		if song.Version != 1 {
+			t.Fatalf("Save returned version %d, want 1", song.Version)
+		}
+	})
+
+	t.Run("SaveMany", func(t *testing.T) {
+		store := newStore(t)
+		existing := mustSave(t, store, "Existing", "Artist")
+		batch := []*model.Song{
+			{Title: "A", Artist: "X", Album: "Album", Duration: "3:45", Link: "123"},
+			{ID: existing.ID, Title: "Duplicate", Artist: "X", Album: "Album", Duration: "3:45", Link: "123"},
+			{Title: "B", Artist: "X", Album: "Album", Duration: "3:45", Link: "123"},
+		}
+		saved, failures, err := store.SaveMany(batch)
+		if err != nil {
+			t.Fatalf("SaveMany: %v", err)
+		}
+		if len(saved) != 2 || saved[0].Title != "A" || saved[1].Title != "B" {
+			t.Fatalf("SaveMany saved %+v, want songs A and B", saved)
+		}
+		for _, song := range saved {
+			if song.ID.IsZero() || song.Version != 1 {
+				t.Fatalf("SaveMany returned %+v, want an ID and version 1", song)
+			}
+			if found, err := store.FindByID(song.ID.Hex()); err != nil || found != song {
+				t.Fatalf("FindByID of saved song returned %+v, %v, want %+v", found, err, song)
+			}
+		}
+		if len(failures) != 1 || failures[0].Index != 1 || !errors.Is(failures[0].Err, repository.ErrAlreadyExists) {
+			t.Fatalf("SaveMany reported failures %+v, want index 1 with ErrAlreadyExists", failures)
+		}
+	})
+
+	t.Run("FindByID", func(t *testing.T) {
+		store := newStore(t)
+		saved := mustSave(t, store, "Title", "Artist")
+		found, err := store.FindByID(saved.ID.Hex())
+		if err != nil {
+			t.Fatalf("FindByID: %v", err)
+		}
+		if found != saved {
+			t.Fatalf("FindByID returned %+v, want %+v", found, saved)
+		}
+		if _, err := store.FindByID(primitive.NewObjectID().Hex()); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("FindByID of unknown song returned %v, want ErrNotFound", err)
+		}
+		if _, err := store.FindByID("not-an-id"); !errors.Is(err, repository.ErrInvalidID) {
+			t.Fatalf("FindByID of malformed id returned %v, want ErrInvalidID", err)
+		}
+	})
+
+	t.Run("FindAllOrderedByID", func(t *testing.T) {
+		store := newStore(t)
+		songs, err := store.FindAll()
+		if err != nil || len(songs) != 0 {
+			t.Fatalf("FindAll on empty store returned %v, %v", songs, err)
+		}
+		want := []model.Song{mustSave(t, store, "A", "X"), mustSave(t, store, "B", "X"), mustSave(t, store, "C", "X")}
+		songs, err = store.FindAll()
+		if err != nil {
+			t.Fatalf("FindAll: %v", err)
+		}
+		assertSongs(t, "FindAll", songs, want)
+	})
+
+	t.Run("FindPageAndCount", func(t *testing.T) {
+		store := newStore(t)
+		var all []model.Song
+		for _, title := range []string{"A", "B", "C", "D", "E"} {
+			all = append(all, mustSave(t, store, title, "X"))
+		}
+		first, err := store.FindPage("", 2)
+		if err != nil {
+			t.Fatalf("FindPage: %v", err)
+		}
+		assertSongs(t, "first page", first, all[:2])
+		rest, err := store.FindPage(first[1].ID.Hex(), 10)
+		if err != nil {
+			t.Fatalf("FindPage: %v", err)
+		}
+		assertSongs(t, "last page", rest, all[2:])
+		if _, err := store.FindPage("not-an-id", 2); !errors.Is(err, repository.ErrInvalidID) {
+			t.Fatalf("FindPage after malformed id returned %v, want ErrInvalidID", err)
+		}
+		total, err := store.Count()
+		if err != nil || total != 5 {
+			t.Fatalf("Count returned %d, %v, want 5", total, err)
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		store := newStore(t)
+		blue := mustSave(t, store, "Blue Monday", "New Order")
+		mustSave(t, store, "Yellow", "Coldplay")
+		songs, err := store.Search(model.SongFilter{Query: "monday"}, 10)
+		if err != nil {
+			t.Fatalf("Search: %v", err)
+		}
+		assertSongs(t, "Search by query", songs, []model.Song{blue})
+		songs, err = store.Search(model.SongFilter{Artist: "Coldplay"}, 10)
+		if err != nil || len(songs) != 1 || songs[0].Title != "Yellow" {
+			t.Fatalf("Search by artist returned %v, %v", songs, err)
+		}
+	})
+
+	t.Run("Each", func(t *testing.T) {
+		store := newStore(t)
+		a := mustSave(t, store, "A", "X")
+		mustSave(t, store, "B", "Y")
+		c := mustSave(t, store, "C", "X")
+		trashed := mustSave(t, store, "D", "X")
+		if _, err := store.Delete(trashed.ID.Hex(), trashed.Version); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+
+		var songs []model.Song
+		err := store.Each(context.Background(), model.SongFilter{Artist: "X"}, func(song model.Song) error {
+			songs = append(songs, song)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Each: %v", err)
+		}
+		assertSongs(t, "Each", songs, []model.Song{a, c})
+
+		stop := errors.New("stop")
+		calls := 0
+		err = store.Each(context.Background(), model.SongFilter{}, func(model.Song) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) || calls != 1 {
+			t.Fatalf("Each returned %v after %d calls, want the error of fn after 1 call", err, calls)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		store := newStore(t)
+		saved := mustSave(t, store, "Old", "Artist")
+		changed := saved
+		changed.Title = "New"
+		updated, err := store.Update(&changed)
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if updated.Title != "New" || updated.Artist != "Artist" || updated.Version != saved.Version+1 {
+			t.Fatalf("Update returned %+v, want the song after the update", updated)
+		}
+		found, err := store.FindByID(saved.ID.Hex())
+		if err != nil || found.Title != "New" {
+			t.Fatalf("FindByID after Update returned %+v, %v", found, err)
+		}
+		if _, err := store.Update(&changed); !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Update with stale version returned %v, want ErrVersionConflict", err)
+		}
+		missing := changed
+		missing.ID = primitive.NewObjectID()
+		if _, err := store.Update(&missing); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("Update of unknown song returned %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		store := newStore(t)
+		saved := mustSave(t, store, "Title", "Artist")
+		if _, err := store.Delete(saved.ID.Hex(), saved.Version+1); !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Delete with wrong version returned %v, want ErrVersionConflict", err)
+		}
+		deleted, err := store.Delete(saved.ID.Hex(), saved.Version)
+		if err != nil || !deleted {
+			t.Fatalf("Delete returned %v, %v", deleted, err)
+		}
+		if _, err := store.FindByID(saved.ID.Hex()); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("FindByID after Delete returned %v, want ErrNotFound", err)
+		}
+		if _, err := store.Delete(saved.ID.Hex(), saved.Version); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("second Delete returned %v, want ErrNotFound", err)
+		}
+		if _, err := store.Delete("not-an-id", 1); !errors.Is(err, repository.ErrInvalidID) {
+			t.Fatalf("Delete of malformed id returned %v, want ErrInvalidID", err)
+		}
+	})
+
+	t.Run("Trash", func(t *testing.T) {
+		store := newStore(t)
+		kept := mustSave(t, store, "Kept", "Artist")
+		trashed := mustSave(t, store, "Trashed", "Artist")
+		if _, err := store.Delete(trashed.ID.Hex(), trashed.Version); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		songs, err := store.FindAll()
+		if err != nil {
+			t.Fatalf("FindAll: %v", err)
+		}
+		assertSongs(t, "FindAll with trash", songs, []model.Song{kept})
+		if total, err := store.Count(); err != nil || total != 1 {
+			t.Fatalf("Count with trash returned %d, %v, want 1", total, err)
+		}
+		songs, err = store.Search(model.SongFilter{Query: "trashed"}, 10)
+		if err != nil || len(songs) != 0 {
+			t.Fatalf("Search for trashed song returned %v, %v", songs, err)
+		}
+		songs, err = store.FindDeleted("", 10)
+		if err != nil || len(songs) != 1 || songs[0].ID != trashed.ID || songs[0].DeletedAt == nil {
+			t.Fatalf("FindDeleted returned %+v, %v", songs, err)
+		}
+
+		restored, err := store.Restore(trashed.ID.Hex())
+		if err != nil || restored.DeletedAt != nil {
+			t.Fatalf("Restore returned %+v, %v", restored, err)
+		}
+		if _, err := store.FindByID(trashed.ID.Hex()); err != nil {
+			t.Fatalf("FindByID after Restore: %v", err)
+		}
+		if _, err := store.Restore(trashed.ID.Hex()); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("Restore of song outside the trash returned %v, want ErrNotFound", err)
+		}
+		if _, err := store.Purge(kept.ID.Hex()); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("Purge of song outside the trash returned %v, want ErrNotFound", err)
+		}
+
+		if _, err := store.Delete(kept.ID.Hex(), kept.Version); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if purged, err := store.Purge(kept.ID.Hex()); err != nil || !purged {
+			t.Fatalf("Purge returned %v, %v", purged, err)
+		}
+		if _, err := store.Delete(restored.ID.Hex(), restored.Version); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if ids, err := store.PurgeDeletedBefore(time.Now().Add(-time.Hour)); err != nil || len(ids) != 0 {
+			t.Fatalf("PurgeDeletedBefore an hour ago returned %v, %v, want none", ids, err)
+		}
+		if ids, err := store.PurgeDeletedBefore(time.Now().Add(time.Minute)); err != nil || len(ids) != 1 || ids[0] != restored.ID {
+			t.Fatalf("PurgeDeletedBefore returned %v, %v, want [%s]", ids, err, restored.ID.Hex())
+		}
+		songs, err = store.FindDeleted("", 10)
+		if err != nil || len(songs) != 0 {
+			t.Fatalf("FindDeleted after purge returned %v, %v", songs, err)
+		}
+	})
+}
+
+// TestAuditStore runs the conformance suite against the AuditStore returned by newStore.
+// newStore is called once per subtest and must return an empty store.
+func TestAuditStore(t *testing.T, newStore func(t *testing.T) repository.AuditStore) {
+	t.Run("RecordAndFind", func(t *testing.T) {
+		store := newStore(t)
+		songID := primitive.NewObjectID()
+		before := model.Song{ID: songID, Title: "Old", Artist: "Artist", Version: 1}
+		after := model.Song{ID: songID, Title: "New", Artist: "Artist", Version: 2}
+		created, err := store.Record(&model.SongAudit{SongID: songID, Actor: "alice", RPC: "CreateSong", Time: time.Now(), After: &before})
+		if err != nil || created.ID.IsZero() {
+			t.Fatalf("Record returned %+v, %v", created, err)
+		}
+		updated, err := store.Record(&model.SongAudit{SongID: songID, Actor: "bob", RPC: "UpdateSong", Time: time.Now(), Before: &before, After: &after})
+		if err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+		if _, err := store.Record(&model.SongAudit{SongID: primitive.NewObjectID(), Actor: "carol", RPC: "CreateSong", Time: time.Now()}); err != nil {
+			t.Fatalf("Record: %v", err)
+		}
+
+		audits, err := store.FindBySong(songID.Hex())
+		if err != nil || len(audits) != 2 {
+			t.Fatalf("FindBySong returned %+v, %v", audits, err)
+		}
+		if audits[0].ID != updated.ID || audits[1].ID != created.ID {
+			t.Fatalf("FindBySong returned %s, %s, want newest first", audits[0].RPC, audits[1].RPC)
+		}
+		if audits[0].Actor != "bob" || audits[0].Before == nil || *audits[0].Before != before || audits[0].After == nil || *audits[0].After != after {
+			t.Fatalf("FindBySong returned %+v, snapshots do not match", audits[0])
+		}
+		if audits[1].Before != nil {
+			t.Fatalf("FindBySong returned before snapshot %+v for a creation", audits[1].Before)
+		}
+
+		found, err := store.FindByID(created.ID.Hex())
+		if err != nil || found.RPC != "CreateSong" || found.After == nil || found.After.Title != "Old" {
+			t.Fatalf("FindByID returned %+v, %v", found, err)
+		}
+		if _, err := store.FindByID(primitive.NewObjectID().Hex()); !errors.Is(err, repository.ErrNotFound) {
+			t.Fatalf("FindByID of unknown entry returned %v, want ErrNotFound", err)
+		}
+		if _, err := store.FindBySong("not-an-id"); !errors.Is(err, repository.ErrInvalidID) {
+			t.Fatalf("FindBySong of malformed id returned %v, want ErrInvalidID", err)
+		}
+	})
+}
+
+// mustSave saves a song with the given title and artist and fixed album, duration and link.
+func mustSave(t *testing.T, store repository.SongStore, title, artist string) model.Song {
+	t.Helper()
+	song, err := store.Save(&model.Song{
+		Title:    title,
+		Artist:   artist,
+		Album:    "Album",
+		Duration: "3:45",
+		Link:     "123",
+	})
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	return song
+}
+
+// assertSongs fails the test unless got and want hold the same songs in the same order.
+func assertSongs(t *testing.T, name string, got, want []model.Song) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s returned %d songs, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s song %d is %+v, want %+v", name, i, got[i], want[i])
+		}
+	}
+}
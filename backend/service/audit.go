@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListSongHistory retrieves the audit trail of a song, newest change first.
+// It takes a context and a string value (song ID) as input.
+// It returns the history of the song along with any error encountered.
+func (s *SongService) ListSongHistory(ctx context.Context, id *wrappers.StringValue) (*musicplaylist.SongHistory, error) {
+	log.Printf("ListSongHistory(%s) \n", id.GetValue())
+
+	audits, err := s.audit.FindBySong(id.GetValue())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", id.GetValue())
+	}
+
+	history := &musicplaylist.SongHistory{}
+	for _, a := range audits {
+		history.Entries = append(history.Entries, s.toSongAudit(&a))
+	}
+
+	return history, nil
+}
+
+// RevertSong restores a song to the state it had right after the given audit entry.
+// It takes a context and a musicplaylist.RevertSongRequest as input.
+// It returns the song as stored after the revert along with any error encountered.
+func (s *SongService) RevertSong(ctx context.Context, req *musicplaylist.RevertSongRequest) (*musicplaylist.Song, error) {
+	log.Printf("RevertSong(%v) \n", req)
+
+	// Check if the version the revert is based on is provided
+	if req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "RevertSong must provide the song version")
+	}
+
+	// Retrieve the audit entry holding the snapshot to revert to
+	audit, err := s.audit.FindByID(req.GetAuditId())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "audit entry", req.GetAuditId())
+	}
+	if audit.SongID.Hex() != req.GetSongId() {
+		return nil, status.Errorf(codes.InvalidArgument, "audit entry %s does not belong to song %s", req.GetAuditId(), req.GetSongId())
+	}
+	if audit.After == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "audit entry %s has no song to revert to", req.GetAuditId())
+	}
+
+	// Retrieve the stored song for the audit trail
+	current, err := s.repo.FindByID(req.GetSongId())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", req.GetSongId())
+	}
+
+	// Update the song with the fields of the snapshot
+	revision := *audit.After
+	revision.ID = current.ID
+	revision.Version = req.GetVersion()
+	revision.DeletedAt = nil
+	song, err := s.repo.Update(&revision)
+	if err != nil {
+		log.Printf("Fail RevertSong %v \n", err)
+		return nil, toStatus(err, "song", req.GetSongId())
+	}
+	s.record(ctx, "RevertSong", song.ID, &current, &song)
+
+	return s.toSong(&song), nil
+}
+
+// record adds an entry for a change made by the named RPC to the audit trail of a song.
+// The change itself is already stored, so failing to record it is logged instead of failing the RPC.
+func (s *SongService) record(ctx context.Context, rpc string, songID primitive.ObjectID, before, after *model.Song) {
+	_, err := s.audit.Record(&model.SongAudit{
+		SongID: songID,
+		Actor:  actorFromContext(ctx),
+		RPC:    rpc,
+		Time:   time.Now().UTC(),
+		Before: before,
+		After:  after,
+	})
+	if err != nil {
+		log.Printf("Fail to record %s of song %s: %v \n", rpc, songID.Hex(), err)
+	}
+	s.publish(before, after)
+}
+
+// actorFromContext returns who is calling the RPC, the subject of the authenticated caller.
+// The actor is never taken from what the caller sends, so it cannot be forged.
+// Calls reach the services only once authenticated, "unknown" is returned for calls without identity.
+func actorFromContext(ctx context.Context) string {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		return identity.Subject
+	}
+	return "unknown"
+}
+
+// toSongAudit converts a model.SongAudit to a musicplaylist.SongAudit.
+// It takes a model audit entry as input and returns the equivalent gRPC audit entry.
+func (s *SongService) toSongAudit(a *model.SongAudit) *musicplaylist.SongAudit {
+	entry := &musicplaylist.SongAudit{
+		Id:     a.ID.Hex(),
+		SongId: a.SongID.Hex(),
+		Actor:  a.Actor,
+		Rpc:    a.RPC,
+		Time:   timestamppb.New(a.Time),
+	}
+	if a.Before != nil {
+		entry.Before = s.toSong(a.Before)
+	}
+	if a.After != nil {
+		entry.After = s.toSong(a.After)
+	}
+	return entry
+}
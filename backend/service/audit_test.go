@@ -0,0 +1,37 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuditActorIsTheAuthenticatedCaller(t *testing.T) {
+	// The x-actor metadata sent by the caller must never be recorded as the actor.
+	forged := metadata.NewIncomingContext(context.Background(), metadata.Pairs("x-actor", "mallory"))
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"authenticated", context.WithValue(forged, identityKey{}, Identity{Subject: "alice"}), "alice"},
+		{"without identity", forged, "unknown"},
+	}
+	for _, tt := range tests {
+		s := newTestSongService()
+		song, err := s.CreateSong(tt.ctx, &musicplaylist.Song{Title: "Song", Artist: "Artist", Duration: "3:00", Link: "https://www.youtube.com/watch?v=a"})
+		if err != nil {
+			t.Fatalf("%s: CreateSong: %v", tt.name, err)
+		}
+		history, err := s.ListSongHistory(tt.ctx, &wrappers.StringValue{Value: song.Id})
+		if err != nil {
+			t.Fatalf("%s: ListSongHistory: %v", tt.name, err)
+		}
+		if len(history.Entries) != 1 || history.Entries[0].Actor != tt.want {
+			t.Errorf("%s: history is %v, want one entry by %s", tt.name, history.Entries, tt.want)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/subtle"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/authz"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// authMetadataKey is the gRPC metadata key carrying the bearer token of the caller.
+const authMetadataKey = "authorization"
+
+// Identity is the authenticated caller of an RPC.
+type Identity struct {
+	Subject string   // Name of the user or the API key holder
+	Roles   []string // Roles granting access to RPCs through the authorization policy
+}
+
+// identityKey is the context key under which the Identity of the caller is stored.
+type identityKey struct{}
+
+// IdentityFromContext returns the identity of the caller injected by the auth interceptors.
+// It returns false when the call was not authenticated.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// APIKey is a static key accepted as bearer token, for scripts and services that cannot obtain a JWT.
+type APIKey struct {
+	Key     string
+	Subject string   // Identity of the callers using the key
+	Roles   []string // Roles of the callers using the key
+}
+
+// AuthConfig holds the credentials accepted by an Authenticator.
+type AuthConfig struct {
+	HMACSecret   []byte         // Secret of JWTs signed with HS256, HS384 or HS512
+	RSAPublicKey *rsa.PublicKey // Public key of JWTs signed with RS256, RS384 or RS512
+	Issuer       string         // Required iss claim of JWTs, if set
+	Audience     string         // Required aud claim of JWTs, if set
+	APIKeys      []APIKey
+}
+
+// Authenticator checks the bearer tokens sent by the callers of the gRPC services.
+type Authenticator struct {
+	config  AuthConfig
+	methods []string
+}
+
+// NewAuthenticator creates a new instance of Authenticator.
+// It takes the accepted credentials as input and returns an error if none are configured,
+// since every call would then be rejected, or if a secret or API key is empty or still a placeholder.
+func NewAuthenticator(config AuthConfig) (*Authenticator, error) {
+	var methods []string
+	if len(config.HMACSecret) > 0 {
+		if err := authz.CheckSecret("JWT secret", string(config.HMACSecret)); err != nil {
+			return nil, err
+		}
+		methods = append(methods, jwt.SigningMethodHS256.Alg(), jwt.SigningMethodHS384.Alg(), jwt.SigningMethodHS512.Alg())
+	}
+	for _, key := range config.APIKeys {
+		if err := authz.CheckSecret("API key of "+key.Subject, key.Key); err != nil {
+			return nil, err
+		}
+	}
+	if config.RSAPublicKey != nil {
+		methods = append(methods, jwt.SigningMethodRS256.Alg(), jwt.SigningMethodRS384.Alg(), jwt.SigningMethodRS512.Alg())
+	}
+	if len(methods) == 0 && len(config.APIKeys) == 0 {
+		return nil, errors.New("no JWT key or API key configured")
+	}
+	return &Authenticator{config: config, methods: methods}, nil
+}
+
+// UnaryInterceptor returns the interceptor authenticating unary calls.
+// The identity of the caller is injected into the context of the call, calls without a valid token fail with codes.Unauthenticated.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authenticate(ctx)
+		if err != nil {
+			log.Printf("Rejected %s: %v \n", info.FullMethod, err)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns the interceptor authenticating streaming calls, the same way as UnaryInterceptor.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context())
+		if err != nil {
+			log.Printf("Rejected %s: %v \n", info.FullMethod, err)
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authenticate checks the bearer token of the call and returns the context carrying the identity of the caller.
+func (a *Authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := a.identify(token)
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
+
+// identify returns the identity the token belongs to.
+// Static API keys are checked first, any other token must be a JWT signed with a configured key and carry a subject.
+// The roles of a JWT are taken from its roles claim.
+func (a *Authenticator) identify(token string) (Identity, error) {
+	for _, key := range a.config.APIKeys {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(key.Key)) == 1 {
+			return Identity{Subject: key.Subject, Roles: key.Roles}, nil
+		}
+	}
+	if len(a.methods) == 0 {
+		return Identity{}, status.Error(codes.Unauthenticated, "invalid API key")
+	}
+
+	options := []jwt.ParserOption{jwt.WithValidMethods(a.methods), jwt.WithExpirationRequired()}
+	if a.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(a.config.Issuer))
+	}
+	if a.config.Audience != "" {
+		options = append(options, jwt.WithAudience(a.config.Audience))
+	}
+	claims := &authz.Claims{}
+	_, err := jwt.ParseWithClaims(token, claims, a.key, options...)
+	if err != nil {
+		return Identity{}, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	if claims.Subject == "" {
+		return Identity{}, status.Error(codes.Unauthenticated, "invalid token: missing subject")
+	}
+	return Identity{Subject: claims.Subject, Roles: claims.Roles}, nil
+}
+
+// key returns the key verifying the signature of a JWT, by the family of its signing method.
+func (a *Authenticator) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return a.config.HMACSecret, nil
+	case *jwt.SigningMethodRSA:
+		return a.config.RSAPublicKey, nil
+	}
+	return nil, jwt.ErrTokenUnverifiable
+}
+
+// bearerToken returns the token of the "Bearer <token>" authorization metadata of the call.
+func bearerToken(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authMetadataKey)
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "missing bearer token")
+	}
+	scheme, token, found := strings.Cut(values[0], " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// authenticatedStream is a grpc.ServerStream whose context carries the identity of the caller.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns the context of the stream carrying the identity of the caller.
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/authz"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// testSecret is the HMAC secret of the JWTs accepted by newTestAuthenticator.
+const testSecret = "test-jwt-secret-0123456789abcdef"
+
+// newTestAuthenticator returns an authenticator accepting JWTs signed with testSecret and the API key "test-api-key".
+func newTestAuthenticator(t *testing.T) *Authenticator {
+	a, err := NewAuthenticator(AuthConfig{
+		HMACSecret: []byte(testSecret),
+		APIKeys:    []APIKey{{Key: "test-api-key", Subject: "scripts", Roles: []string{authz.RoleEditor}}},
+	})
+	if err != nil {
+		t.Fatalf("NewAuthenticator: %v", err)
+	}
+	return a
+}
+
+// signToken returns a JWT with claims signed by method with key.
+func signToken(t *testing.T, method jwt.SigningMethod, key interface{}, claims *authz.Claims) string {
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("signing token: %v", err)
+	}
+	return token
+}
+
+// validClaims returns the claims of a token of alice valid for an hour.
+func validClaims() *authz.Claims {
+	return &authz.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "alice",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles: []string{authz.RoleViewer},
+	}
+}
+
+func TestAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired := validClaims()
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+	noSubject := validClaims()
+	noSubject.Subject = ""
+	noExpiry := validClaims()
+	noExpiry.ExpiresAt = nil
+
+	tests := []struct {
+		name          string
+		authorization []string // Values of the authorization metadata, none when nil
+		want          codes.Code
+		subject       string // Subject of the identity passed to the handler when the call is accepted
+	}{
+		{"valid token", []string{"Bearer " + signToken(t, jwt.SigningMethodHS256, []byte(testSecret), validClaims())}, codes.OK, "alice"},
+		{"lower-case scheme", []string{"bearer " + signToken(t, jwt.SigningMethodHS512, []byte(testSecret), validClaims())}, codes.OK, "alice"},
+		{"expired token", []string{"Bearer " + signToken(t, jwt.SigningMethodHS256, []byte(testSecret), expired)}, codes.Unauthenticated, ""},
+		{"token without expiry", []string{"Bearer " + signToken(t, jwt.SigningMethodHS256, []byte(testSecret), noExpiry)}, codes.Unauthenticated, ""},
+		{"wrong secret", []string{"Bearer " + signToken(t, jwt.SigningMethodHS256, []byte("another-secret-0123456789abcdef"), validClaims())}, codes.Unauthenticated, ""},
+		{"unconfigured algorithm", []string{"Bearer " + signToken(t, jwt.SigningMethodRS256, rsaKey, validClaims())}, codes.Unauthenticated, ""},
+		{"none algorithm", []string{"Bearer " + signToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, validClaims())}, codes.Unauthenticated, ""},
+		{"missing subject", []string{"Bearer " + signToken(t, jwt.SigningMethodHS256, []byte(testSecret), noSubject)}, codes.Unauthenticated, ""},
+		{"valid API key", []string{"Bearer test-api-key"}, codes.OK, "scripts"},
+		{"unknown API key", []string{"Bearer unknown-api-key"}, codes.Unauthenticated, ""},
+		{"missing metadata", nil, codes.Unauthenticated, ""},
+		{"empty token", []string{"Bearer "}, codes.Unauthenticated, ""},
+		{"basic scheme", []string{"Basic dXNlcjpwYXNzd29yZA=="}, codes.Unauthenticated, ""},
+		{"token without scheme", []string{"test-api-key"}, codes.Unauthenticated, ""},
+	}
+
+	a := newTestAuthenticator(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != nil {
+				ctx = metadata.NewIncomingContext(ctx, metadata.MD{authMetadataKey: tt.authorization})
+			}
+			var identity Identity
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				identity, _ = IdentityFromContext(ctx)
+				return nil, nil
+			}
+			_, err := a.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/protoapi.SongApi/GetSong"}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Fatalf("got %v (%v), want %v", got, err, tt.want)
+			}
+			if identity.Subject != tt.subject {
+				t.Errorf("handler got subject %q, want %q", identity.Subject, tt.subject)
+			}
+		})
+	}
+}
+
+func TestNewAuthenticatorRejectsPlaceholders(t *testing.T) {
+	configs := map[string]AuthConfig{
+		"no credentials":      {},
+		"placeholder secret":  {HMACSecret: []byte("change-this-secret")},
+		"placeholder API key": {APIKeys: []APIKey{{Key: "change-this-key", Subject: "scripts"}}},
+		"empty API key":       {APIKeys: []APIKey{{Subject: "scripts"}}},
+	}
+	for name, config := range configs {
+		if _, err := NewAuthenticator(config); err == nil {
+			t.Errorf("%s: NewAuthenticator succeeded, want an error", name)
+		}
+	}
+}
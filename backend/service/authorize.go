@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"log"
+	"path"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/authz"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Authorizer checks the roles of the callers of the gRPC services against a policy.
+// It must run after the interceptors of the Authenticator, which inject the identity of the caller.
+type Authorizer struct {
+	policy authz.Policy
+}
+
+// NewAuthorizer creates a new instance of Authorizer.
+// It takes the policy listing the roles allowed to call each RPC as input.
+func NewAuthorizer(policy authz.Policy) *Authorizer {
+	return &Authorizer{policy: policy}
+}
+
+// UnaryInterceptor returns the interceptor authorizing unary calls.
+// Calls by callers without a role allowed to call the RPC fail with codes.PermissionDenied.
+func (a *Authorizer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			log.Printf("Denied %s: %v \n", info.FullMethod, err)
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns the interceptor authorizing streaming calls, the same way as UnaryInterceptor.
+func (a *Authorizer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			log.Printf("Denied %s: %v \n", info.FullMethod, err)
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// authorize checks that the caller may call the method, given by its full name such as /protoapi.SongApi/DeleteSong.
+// Methods of every service are checked, the methods missing from the policy are denied to everyone.
+func (a *Authorizer) authorize(ctx context.Context, method string) error {
+	identity, ok := IdentityFromContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing identity")
+	}
+	rpc := policyName(method)
+	if !a.policy.Allows(rpc, identity.Roles) {
+		return status.Errorf(codes.PermissionDenied, "%s is not allowed to call %s", identity.Subject, rpc)
+	}
+	return nil
+}
+
+// policyName returns the name of a method in the policy, its service without the package and the method,
+// such as SongApi/DeleteSong for /protoapi.SongApi/DeleteSong.
+func policyName(method string) string {
+	service, rpc := path.Split(strings.TrimPrefix(method, "/"))
+	service = strings.TrimSuffix(service, "/")
+	return service[strings.LastIndex(service, ".")+1:] + "/" + rpc
+}
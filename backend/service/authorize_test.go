@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dwiyasa-Nakula/master/backend/authz"
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callAs calls method through the unary interceptor of the authorizer as a caller holding roles.
+// It returns the code of the call, codes.OK when the handler was reached.
+func callAs(a *Authorizer, method string, roles ...string) codes.Code {
+	ctx := context.WithValue(context.Background(), identityKey{}, Identity{Subject: "tester", Roles: roles})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	_, err := a.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+	return status.Code(err)
+}
+
+func TestAuthorizerDefaultPolicy(t *testing.T) {
+	a := NewAuthorizer(authz.DefaultPolicy())
+	tests := []struct {
+		method string
+		role   string
+		want   codes.Code
+	}{
+		{musicplaylist.PlaylistApi_RenamePlaylist_FullMethodName, authz.RoleViewer, codes.PermissionDenied},
+		{musicplaylist.PlaylistApi_AddTrack_FullMethodName, authz.RoleViewer, codes.PermissionDenied},
+		{musicplaylist.PlaylistApi_DeletePlaylist_FullMethodName, authz.RoleEditor, codes.PermissionDenied},
+		{musicplaylist.PlaylistApi_RenamePlaylist_FullMethodName, authz.RoleEditor, codes.OK},
+		{musicplaylist.PlaylistApi_GetPlaylist_FullMethodName, authz.RoleViewer, codes.OK},
+		{musicplaylist.PlaylistApi_ExportM3U_FullMethodName, authz.RoleViewer, codes.OK},
+		{musicplaylist.SongApi_CreateSong_FullMethodName, authz.RoleViewer, codes.PermissionDenied},
+		{musicplaylist.SongApi_DeleteSong_FullMethodName, authz.RoleEditor, codes.PermissionDenied},
+		{musicplaylist.SongApi_DeleteSong_FullMethodName, authz.RoleAdmin, codes.OK},
+		{"/protoapi.UnknownApi/GetSong", authz.RoleAdmin, codes.PermissionDenied},
+	}
+	for _, tt := range tests {
+		if got := callAs(a, tt.method, tt.role); got != tt.want {
+			t.Errorf("%s as %s: got %v, want %v", tt.method, tt.role, got, tt.want)
+		}
+	}
+}
+
+func TestAuthorizerOverride(t *testing.T) {
+	a := NewAuthorizer(authz.DefaultPolicy().Override([]authz.Rule{
+		{RPC: "PlaylistApi/RenamePlaylist", Roles: []string{authz.RoleViewer}},
+	}))
+	if got := callAs(a, musicplaylist.PlaylistApi_RenamePlaylist_FullMethodName, authz.RoleViewer); got != codes.OK {
+		t.Errorf("overridden RenamePlaylist as viewer: got %v, want OK", got)
+	}
+	if got := callAs(a, musicplaylist.PlaylistApi_RenamePlaylist_FullMethodName, authz.RoleEditor); got != codes.PermissionDenied {
+		t.Errorf("overridden RenamePlaylist as editor: got %v, want PermissionDenied", got)
+	}
+}
+
+func TestAuthorizerMissingIdentity(t *testing.T) {
+	a := NewAuthorizer(authz.DefaultPolicy())
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: musicplaylist.PlaylistApi_GetPlaylist_FullMethodName}
+	_, err := a.UnaryInterceptor()(context.Background(), nil, info, handler)
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got %v, want Unauthenticated", err)
+	}
+}
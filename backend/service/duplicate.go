@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons given for duplicates.
+const (
+	duplicateLink               = "same link"
+	duplicateTitleArtist        = "same title and artist"
+	duplicateEarlierLink        = "same link as an earlier row"
+	duplicateEarlierTitleArtist = "same title and artist as an earlier row"
+)
+
+// CheckDuplicates looks for stored songs matching the songs of the request, either by link or by title and artist
+// ignoring case, punctuation and spacing. A song matching both ways reports the song with the same link.
+// Songs matching no stored song but an earlier song of the request the same way report that song, without ID,
+// so the caller can choose to skip them or keep both instead of storing the song twice unknowingly.
+// The catalog is read once, so the cost does not depend on the number of songs checked.
+// It takes a context and a musicplaylist.CheckDuplicatesRequest as input.
+// It returns the duplicates found, ordered by index, along with any error encountered.
+func (s *SongService) CheckDuplicates(ctx context.Context, req *musicplaylist.CheckDuplicatesRequest) (*musicplaylist.DuplicateReport, error) {
+	log.Printf("CheckDuplicates(%d songs) \n", len(req.GetSongs()))
+
+	// Index the songs of the request by their keys
+	byLink := map[string][]int{}
+	byTitleArtist := map[string][]int{}
+	for i, tm := range req.GetSongs() {
+		song := model.Song{Title: tm.GetTitle(), Artist: tm.GetArtist(), Link: tm.GetLink()}
+		if key := song.LinkKey(); key != "" {
+			byLink[key] = append(byLink[key], i)
+		}
+		if key := song.DuplicateKey(); key != "" {
+			byTitleArtist[key] = append(byTitleArtist[key], i)
+		}
+	}
+
+	// Match every stored song against the index
+	found := make([]*musicplaylist.Duplicate, len(req.GetSongs()))
+	err := s.repo.Each(ctx, model.SongFilter{}, func(song model.Song) error {
+		for _, i := range byLink[song.LinkKey()] {
+			if found[i] == nil || found[i].Reason != duplicateLink {
+				found[i] = &musicplaylist.Duplicate{Index: int32(i), Existing: s.toSong(&song), Reason: duplicateLink}
+			}
+		}
+		for _, i := range byTitleArtist[song.DuplicateKey()] {
+			if found[i] == nil {
+				found[i] = &musicplaylist.Duplicate{Index: int32(i), Existing: s.toSong(&song), Reason: duplicateTitleArtist}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Printf("Fail CheckDuplicates %v \n", err)
+		return nil, toStatus(err, "song", "")
+	}
+
+	// Match the remaining songs against the earlier songs of the request
+	first := map[string]int{}
+	for i, tm := range req.GetSongs() {
+		song := model.Song{Title: tm.GetTitle(), Artist: tm.GetArtist(), Link: tm.GetLink()}
+		keys := duplicateKeys(&song)
+		for _, key := range keys {
+			j, ok := first[key]
+			if !ok || found[i] != nil {
+				continue
+			}
+			reason := duplicateEarlierTitleArtist
+			if strings.HasPrefix(key, linkKeyPrefix) {
+				reason = duplicateEarlierLink
+			}
+			found[i] = &musicplaylist.Duplicate{Index: int32(i), Existing: req.GetSongs()[j], Reason: reason}
+		}
+		for _, key := range keys {
+			if _, ok := first[key]; !ok {
+				first[key] = i
+			}
+		}
+	}
+
+	report := &musicplaylist.DuplicateReport{}
+	for _, d := range found {
+		if d != nil {
+			report.Duplicates = append(report.Duplicates, d)
+		}
+	}
+	return report, nil
+}
+
+// Prefixes telling the link keys of duplicateKeys apart from the title and artist keys.
+const (
+	linkKeyPrefix        = "link:"
+	titleArtistKeyPrefix = "title:"
+)
+
+// duplicateKeys returns the keys a song shares with its duplicates, its link key first.
+// Songs without link or without title and artist have fewer keys.
+func duplicateKeys(song *model.Song) []string {
+	var keys []string
+	if key := song.LinkKey(); key != "" {
+		keys = append(keys, linkKeyPrefix+key)
+	}
+	if key := song.DuplicateKey(); key != "" {
+		keys = append(keys, titleArtistKeyPrefix+key)
+	}
+	return keys
+}
+
+// ImportRows imports rows whose duplicates were already reviewed: INSERT rows are stored as new songs,
+// SKIP rows are left out and OVERWRITE rows replace the existing song they duplicate.
+// Rows repeating an earlier row are imported with the action chosen for them like any other row.
+// Rows that are invalid or cannot be stored are reported in the summary with their position in the request.
+// It takes a context and a musicplaylist.ImportRowsRequest as input.
+// It returns the summary of the import along with any error encountered.
+func (s *SongService) ImportRows(ctx context.Context, req *musicplaylist.ImportRowsRequest) (*musicplaylist.ImportSummary, error) {
+	log.Printf("ImportRows(%d rows, dry run %v) \n", len(req.GetRows()), req.GetDryRun())
+
+	imp := s.newImport(ctx, "ImportRows", req.GetDryRun())
+	for _, row := range req.GetRows() {
+		var err error
+		switch row.GetAction() {
+		case musicplaylist.ImportRow_SKIP:
+			imp.skip()
+		case musicplaylist.ImportRow_OVERWRITE:
+			imp.overwrite(row.GetSong(), row.GetExistingId(), row.GetExistingVersion())
+		default:
+			err = imp.add(row.GetSong())
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return imp.finish()
+}
+
+// skip counts a row of the import that is deliberately left out.
+func (imp *songImport) skip() {
+	imp.summary.Received++
+	imp.summary.Skipped++
+}
+
+// overwrite replaces the fields of an existing song with the next song of the import.
+// The update only succeeds while the stored song still has the version the duplicate was reviewed at,
+// failures are added to the summary.
+func (imp *songImport) overwrite(tm *musicplaylist.Song, id string, version int64) {
+	index := imp.summary.Received
+	imp.summary.Received++
+	fail := func(err error) {
+		imp.summary.Failures = append(imp.summary.Failures, &musicplaylist.ImportFailure{
+			Index:  index,
+			Reason: failureReason(err),
+		})
+	}
+
+	song, err := validateSong(tm)
+	if err != nil {
+		fail(err)
+		return
+	}
+	if version <= 0 {
+		fail(status.Error(codes.InvalidArgument, "overwriting a song requires its version"))
+		return
+	}
+
+	// Retrieve the stored song for the audit trail
+	current, err := imp.s.repo.FindByID(id)
+	if err != nil {
+		fail(toStatus(err, "song", id))
+		return
+	}
+	if current.Version != version {
+		fail(toStatus(repository.ErrVersionConflict, "song", id))
+		return
+	}
+	if imp.summary.DryRun {
+		imp.summary.Updated++
+		return
+	}
+
+	song.ID = current.ID
+	song.Version = version
+	updated, err := imp.s.repo.Update(song)
+	if err != nil {
+		log.Printf("Fail %s %v \n", imp.rpc, err)
+		fail(toStatus(err, "song", id))
+		return
+	}
+	imp.s.record(imp.ctx, imp.rpc, updated.ID, &current, &updated)
+	imp.summary.Updated++
+}
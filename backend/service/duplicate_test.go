@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+)
+
+// newTestSongService returns a SongService storing songs in memory.
+func newTestSongService() *SongService {
+	events := repository.NewSongEventBus()
+	return NewSongService(repository.NewMemorySongRepo(), repository.NewMemoryAuditRepo(), events, events)
+}
+
+func TestImportRowsAppliesChosenActionToRepeatedRows(t *testing.T) {
+	s := newTestSongService()
+	rows := []*musicplaylist.ImportRow{
+		{Song: &musicplaylist.Song{Title: "Song", Artist: "Artist", Duration: "3:00", Link: "https://www.youtube.com/watch?v=a"}},
+		{Song: &musicplaylist.Song{Title: "song!", Artist: "ARTIST", Duration: "3:00", Link: "https://www.youtube.com/watch?v=b"}},
+		{Song: &musicplaylist.Song{Title: "Other", Artist: "Band", Duration: "3:00", Link: "http://youtube.com/watch?v=a"},
+			Action: musicplaylist.ImportRow_SKIP},
+		{Song: &musicplaylist.Song{Title: "New", Artist: "Band", Duration: "3:00", Link: "https://www.youtube.com/watch?v=c"}},
+	}
+
+	for _, dryRun := range []bool{true, false} {
+		summary, err := s.ImportRows(context.Background(), &musicplaylist.ImportRowsRequest{Rows: rows, DryRun: dryRun})
+		if err != nil {
+			t.Fatalf("ImportRows: %v", err)
+		}
+		if summary.Imported != 3 || summary.Skipped != 1 || len(summary.Failures) != 0 {
+			t.Fatalf("dry run %v: imported %d, skipped %d, failures %v, want 3 imported and 1 skipped",
+				dryRun, summary.Imported, summary.Skipped, summary.Failures)
+		}
+	}
+	if n, _ := s.repo.Count(); n != 3 {
+		t.Fatalf("%d songs stored, want the repeated row kept as asked and 3 songs in total", n)
+	}
+}
+
+func TestCheckDuplicatesReportsRepeatedRows(t *testing.T) {
+	s := newTestSongService()
+	stored, err := s.CreateSong(context.Background(), &musicplaylist.Song{Title: "Stored", Artist: "Artist", Duration: "3:00", Link: "https://www.youtube.com/watch?v=s"})
+	if err != nil {
+		t.Fatalf("CreateSong: %v", err)
+	}
+
+	songs := []*musicplaylist.Song{
+		{Title: "Song", Artist: "Artist", Link: "https://www.youtube.com/watch?v=a"},
+		{Title: "Song", Artist: "Artist", Link: "https://www.youtube.com/watch?v=b"},
+		{Title: "Other", Artist: "Band", Link: "https://www.youtube.com/watch?v=a"},
+		{Title: "Stored", Artist: "Artist", Link: "https://www.youtube.com/watch?v=c"},
+		{Title: "Stored", Artist: "Artist", Link: "https://www.youtube.com/watch?v=d"},
+	}
+	report, err := s.CheckDuplicates(context.Background(), &musicplaylist.CheckDuplicatesRequest{Songs: songs})
+	if err != nil {
+		t.Fatalf("CheckDuplicates: %v", err)
+	}
+
+	want := []struct {
+		index    int32
+		existing string
+		reason   string
+	}{
+		{1, "", duplicateEarlierTitleArtist},
+		{2, "", duplicateEarlierLink},
+		{3, stored.Id, duplicateTitleArtist},
+		{4, stored.Id, duplicateTitleArtist},
+	}
+	if len(report.Duplicates) != len(want) {
+		t.Fatalf("CheckDuplicates returned %v, want %d duplicates", report.Duplicates, len(want))
+	}
+	for i, w := range want {
+		d := report.Duplicates[i]
+		if d.Index != w.index || d.Existing.GetId() != w.existing || d.Reason != w.reason {
+			t.Errorf("duplicate %d is %v, want index %d of %q for %s", i, d, w.index, w.existing, w.reason)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatus converts an error returned by a repository into a gRPC status error.
+// It takes the error, the kind of resource ("song", "playlist") and its ID as input.
+// Errors that already carry a gRPC status are returned unchanged, unknown errors become codes.Internal.
+func toStatus(err error, resource, id string) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrInvalidID):
+		return status.Errorf(codes.InvalidArgument, "invalid %s id %q", resource, id)
+	case errors.Is(err, repository.ErrNotFound):
+		return status.Errorf(codes.NotFound, "%s %s not found", resource, id)
+	case errors.Is(err, repository.ErrAlreadyExists):
+		return status.Errorf(codes.AlreadyExists, "%s %s already exists", resource, id)
+	case errors.Is(err, repository.ErrInvalidPosition):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, repository.ErrConcurrentUpdate):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, repository.ErrVersionConflict):
+		return status.Errorf(codes.Aborted, "%s %s was changed by someone else", resource, id)
+	case errors.Is(err, repository.ErrInvalidResumeToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, repository.ErrResumeTokenExpired):
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Errorf(codes.DeadlineExceeded, "%s storage timed out", resource)
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Errorf(codes.Internal, "%v", err)
+}
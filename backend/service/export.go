@@ -0,0 +1,64 @@
+package service
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportSongs streams every song matching the filters of the request, ordered by ID.
+// Songs are sent while they are read from the repository, so the catalog is never loaded all at once.
+// It takes a musicplaylist.ExportSongsRequest and the server stream as input.
+// It returns any error encountered while exporting.
+func (s *SongService) ExportSongs(req *musicplaylist.ExportSongsRequest, stream musicplaylist.SongApi_ExportSongsServer) error {
+	log.Printf("ExportSongs(%v) \n", req)
+
+	filter, err := toSongFilter(req)
+	if err != nil {
+		return err
+	}
+
+	exported := 0
+	err = s.repo.Each(stream.Context(), filter, func(song model.Song) error {
+		exported++
+		return stream.Send(s.toSong(&song))
+	})
+	if err != nil {
+		log.Printf("Fail ExportSongs %v \n", err)
+		return toStatus(err, "song", "")
+	}
+
+	log.Printf("ExportSongs sent %d songs \n", exported)
+	return nil
+}
+
+// songFilterRequest is a request carrying the song search filters, such as SearchSongsRequest and ExportSongsRequest.
+type songFilterRequest interface {
+	GetQuery() string
+	GetArtist() string
+	GetAlbum() string
+	GetMinDurationMs() int64
+	GetMaxDurationMs() int64
+}
+
+// toSongFilter validates the filters of a request and converts them to a model.SongFilter.
+// It returns a codes.InvalidArgument error for negative or reversed duration ranges.
+func toSongFilter(req songFilterRequest) (model.SongFilter, error) {
+	if req.GetMinDurationMs() < 0 || req.GetMaxDurationMs() < 0 ||
+		(req.GetMaxDurationMs() > 0 && req.GetMinDurationMs() > req.GetMaxDurationMs()) {
+		return model.SongFilter{}, status.Error(codes.InvalidArgument, "invalid duration range")
+	}
+
+	return model.SongFilter{
+		Query:       strings.TrimSpace(req.GetQuery()),
+		Artist:      req.GetArtist(),
+		Album:       req.GetAlbum(),
+		MinDuration: time.Duration(req.GetMinDurationMs()) * time.Millisecond,
+		MaxDuration: time.Duration(req.GetMaxDurationMs()) * time.Millisecond,
+	}, nil
+}
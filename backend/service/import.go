@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	importBatchSize   = 500         // Number of songs stored with each SaveMany call during an import
+	dryRunMetadataKey = "x-dry-run" // gRPC metadata key callers set to "true" to only validate an import
+)
+
+// ImportSongs stores the songs streamed by the client in batches.
+// Songs that are invalid or cannot be stored are reported in the summary with their position in the stream
+// instead of failing the whole import. With the x-dry-run metadata the songs are only validated.
+// It takes the client stream as input and returns any error encountered while receiving.
+func (s *SongService) ImportSongs(stream musicplaylist.SongApi_ImportSongsServer) error {
+	dryRun := dryRunFromContext(stream.Context())
+	log.Printf("ImportSongs(dry run %v) \n", dryRun)
+
+	imp := s.newImport(stream.Context(), "ImportSongs", dryRun)
+	for {
+		tm, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Printf("Fail ImportSongs %v \n", err)
+			return err
+		}
+		if err := imp.add(tm); err != nil {
+			return err
+		}
+	}
+	summary, err := imp.finish()
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(summary)
+}
+
+// songImport stores the songs of an import in batches and keeps its summary.
+type songImport struct {
+	s       *SongService
+	ctx     context.Context
+	rpc     string        // Name of the importing RPC recorded in the audit trail
+	batch   []*model.Song // Validated songs waiting to be stored
+	indexes []int32       // Position in the import of every song of the batch
+	summary *musicplaylist.ImportSummary
+}
+
+// newImport starts an import made by the named RPC, a dry run only validates the songs.
+func (s *SongService) newImport(ctx context.Context, rpc string, dryRun bool) *songImport {
+	return &songImport{
+		s:       s,
+		ctx:     ctx,
+		rpc:     rpc,
+		summary: &musicplaylist.ImportSummary{DryRun: dryRun},
+	}
+}
+
+// add validates the next song of the import and stores the batch once it is full.
+// Invalid songs are added to the failures of the summary, the returned error means the import cannot go on.
+func (imp *songImport) add(tm *musicplaylist.Song) error {
+	index := imp.summary.Received
+	imp.summary.Received++
+	song, err := validateSong(tm)
+	if err != nil {
+		imp.summary.Failures = append(imp.summary.Failures, &musicplaylist.ImportFailure{
+			Index:  index,
+			Reason: failureReason(err),
+		})
+		return nil
+	}
+	if imp.summary.DryRun {
+		imp.summary.Imported++
+		return nil
+	}
+
+	imp.batch = append(imp.batch, song)
+	imp.indexes = append(imp.indexes, index)
+	if len(imp.batch) == importBatchSize {
+		return imp.flush()
+	}
+	return nil
+}
+
+// reject counts the next song of the import as failed for reason without validating it.
+func (imp *songImport) reject(reason string) {
+	imp.summary.Failures = append(imp.summary.Failures, &musicplaylist.ImportFailure{
+		Index:  imp.summary.Received,
+		Reason: reason,
+	})
+	imp.summary.Received++
+}
+
+// finish stores the last batch and returns the summary of the import.
+func (imp *songImport) finish() (*musicplaylist.ImportSummary, error) {
+	if err := imp.flush(); err != nil {
+		return nil, err
+	}
+	log.Printf("%s received %d songs, imported %d \n", imp.rpc, imp.summary.Received, imp.summary.Imported)
+	return imp.summary, nil
+}
+
+// flush stores the current batch and adds the outcome to the summary.
+func (imp *songImport) flush() error {
+	if len(imp.batch) == 0 {
+		return nil
+	}
+
+	saved, failures, err := imp.s.repo.SaveMany(imp.batch)
+	if err != nil {
+		log.Printf("Fail %s %v \n", imp.rpc, err)
+		return toStatus(err, "song", "batch")
+	}
+	for _, f := range failures {
+		imp.summary.Failures = append(imp.summary.Failures, &musicplaylist.ImportFailure{
+			Index:  imp.indexes[f.Index],
+			Reason: failureReason(toStatus(f.Err, "song", imp.batch[f.Index].ID.Hex())),
+		})
+	}
+	for i := range saved {
+		imp.s.record(imp.ctx, imp.rpc, saved[i].ID, nil, &saved[i])
+	}
+	imp.summary.Imported += int32(len(saved))
+	imp.batch, imp.indexes = nil, nil
+	return nil
+}
+
+// failureReason describes why a song was rejected, listing the field violations of validation errors.
+func failureReason(err error) string {
+	st := status.Convert(err)
+	var reasons []string
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				reasons = append(reasons, v.GetDescription())
+			}
+		}
+	}
+	if len(reasons) == 0 {
+		return st.Message()
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// dryRunFromContext reports whether the caller asked to only validate the request with the x-dry-run metadata.
+func dryRunFromContext(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(dryRunMetadataKey)
+	if len(values) == 0 {
+		return false
+	}
+	dryRun, err := strconv.ParseBool(values[0])
+	return err == nil && dryRun
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// PlaylistService handles gRPC requests related to playlists.
+type PlaylistService struct {
+	musicplaylist.UnimplementedPlaylistApiServer // Embed the generated gRPC server interface
+	repo  repository.PlaylistStore               // Repository to interact with the playlists
+	songs repository.SongStore                   // Repository used to check that added songs exist
+}
+
+// NewPlaylistService creates a new instance of PlaylistService.
+func NewPlaylistService(repo repository.PlaylistStore, songs repository.SongStore) *PlaylistService {
+	return &PlaylistService{
+		repo:  repo,
+		songs: songs,
+	}
+}
+
+// CreatePlaylist creates a new, empty playlist.
+// It takes a context and a musicplaylist.Playlist as input.
+// It returns the created playlist along with any error encountered.
+func (s *PlaylistService) CreatePlaylist(ctx context.Context, pl *musicplaylist.Playlist) (*musicplaylist.Playlist, error) {
+	log.Printf("CreatePlaylist(%v) \n", pl)
+
+	// Check if the playlist name is provided
+	name := strings.TrimSpace(pl.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "CreatePlaylist must provide a name")
+	}
+
+	// Save the new playlist in the repository
+	playlist, err := s.repo.Save(&model.Playlist{
+		Name:        name,
+		Description: pl.Description,
+		Owner:       pl.Owner,
+	})
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", "")
+	}
+
+	// Convert the model playlist back to a gRPC playlist and return
+	return s.toPlaylist(&playlist), nil
+}
+
+// GetPlaylist retrieves a single playlist by its ID.
+// It takes a context and a string value (playlist ID) as input.
+// It returns the playlist along with any error encountered.
+func (s *PlaylistService) GetPlaylist(ctx context.Context, id *wrappers.StringValue) (*musicplaylist.Playlist, error) {
+	log.Printf("GetPlaylist(%s) \n", id.GetValue())
+
+	playlist, err := s.repo.FindByID(id.GetValue())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", id.GetValue())
+	}
+
+	return s.toPlaylist(&playlist), nil
+}
+
+// ListPlaylists retrieves all playlists, optionally restricted to one owner.
+// It takes a context and a musicplaylist.ListPlaylistsRequest as input.
+// It returns a list of playlists along with any error encountered.
+func (s *PlaylistService) ListPlaylists(ctx context.Context, req *musicplaylist.ListPlaylistsRequest) (*musicplaylist.PlaylistList, error) {
+	log.Printf("ListPlaylists(%v) \n", req)
+
+	playlists, err := s.repo.FindAll(req.GetOwner())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", "")
+	}
+
+	list := &musicplaylist.PlaylistList{}
+	for _, p := range playlists {
+		list.List = append(list.List, s.toPlaylist(&p))
+	}
+
+	return list, nil
+}
+
+// RenamePlaylist changes the name and description of a playlist.
+// It takes a context and a musicplaylist.RenamePlaylistRequest as input.
+// It returns the updated playlist along with any error encountered.
+func (s *PlaylistService) RenamePlaylist(ctx context.Context, req *musicplaylist.RenamePlaylistRequest) (*musicplaylist.Playlist, error) {
+	log.Printf("RenamePlaylist(%v) \n", req)
+
+	// Check if the new playlist name is provided
+	name := strings.TrimSpace(req.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "RenamePlaylist must provide a name")
+	}
+
+	playlist, err := s.repo.Rename(req.GetId(), name, req.GetDescription())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", req.GetId())
+	}
+
+	return s.toPlaylist(&playlist), nil
+}
+
+// DeletePlaylist deletes an existing playlist.
+// The songs of the playlist are kept.
+// It takes a context and a string value (playlist ID) as input.
+// It returns a boolean indicating the deletion success along with any error encountered.
+func (s *PlaylistService) DeletePlaylist(ctx context.Context, id *wrappers.StringValue) (*wrappers.BoolValue, error) {
+	log.Printf("DeletePlaylist(%s) \n", id.GetValue())
+
+	deleted, err := s.repo.Delete(id.GetValue())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", id.GetValue())
+	}
+
+	return &wrapperspb.BoolValue{Value: deleted}, nil
+}
+
+// AddTrack appends an existing song to the end of a playlist.
+// It takes a context and a musicplaylist.AddTrackRequest as input.
+// It returns the updated playlist along with any error encountered.
+func (s *PlaylistService) AddTrack(ctx context.Context, req *musicplaylist.AddTrackRequest) (*musicplaylist.Playlist, error) {
+	log.Printf("AddTrack(%v) \n", req)
+
+	// Make sure the song exists before adding it
+	song, err := s.songs.FindByID(req.GetSongId())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", req.GetSongId())
+	}
+
+	playlist, err := s.repo.AddTrack(req.GetPlaylistId(), song.ID)
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", req.GetPlaylistId())
+	}
+
+	return s.toPlaylist(&playlist), nil
+}
+
+// RemoveTrack removes the song at the given position from a playlist.
+// It takes a context and a musicplaylist.RemoveTrackRequest as input.
+// It returns the updated playlist along with any error encountered.
+func (s *PlaylistService) RemoveTrack(ctx context.Context, req *musicplaylist.RemoveTrackRequest) (*musicplaylist.Playlist, error) {
+	log.Printf("RemoveTrack(%v) \n", req)
+
+	playlist, err := s.repo.RemoveTrack(req.GetPlaylistId(), int(req.GetPosition()))
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", req.GetPlaylistId())
+	}
+
+	return s.toPlaylist(&playlist), nil
+}
+
+// MoveTrack moves the song at one position of a playlist to another position.
+// It takes a context and a musicplaylist.MoveTrackRequest as input.
+// It returns the updated playlist along with any error encountered.
+func (s *PlaylistService) MoveTrack(ctx context.Context, req *musicplaylist.MoveTrackRequest) (*musicplaylist.Playlist, error) {
+	log.Printf("MoveTrack(%v) \n", req)
+
+	playlist, err := s.repo.MoveTrack(req.GetPlaylistId(), int(req.GetFromPosition()), int(req.GetToPosition()))
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "playlist", req.GetPlaylistId())
+	}
+
+	return s.toPlaylist(&playlist), nil
+}
+
+// toPlaylist converts a model.Playlist to a musicplaylist.Playlist.
+// It takes a model playlist as input and returns the equivalent gRPC playlist.
+func (s *PlaylistService) toPlaylist(p *model.Playlist) *musicplaylist.Playlist {
+	pl := &musicplaylist.Playlist{
+		Id:          p.ID.Hex(),
+		Name:        p.Name,
+		Description: p.Description,
+		Owner:       p.Owner,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+	}
+	for _, songID := range p.SongIDs {
+		pl.SongIds = append(pl.SongIds, songID.Hex())
+	}
+	return pl
+}
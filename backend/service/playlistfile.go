@@ -0,0 +1,268 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// playlistFormat describes a playlist file format songs and playlists can be exported to and imported from.
+type playlistFormat struct {
+	name        string                                     // Name of the format used in messages
+	extension   string                                     // File name extension, including the dot
+	contentType string                                     // Media type of the files
+	writer      func(w io.Writer, title string) songWriter // Creates a writer for a playlist with the given title
+	decode      func(r io.Reader) ([]model.Song, error)    // Reads the songs of a file
+}
+
+// songWriter writes the songs of a playlist file one at a time, Close ends the file.
+type songWriter interface {
+	WriteSong(s model.Song) error
+	Close() error
+}
+
+// m3uFormat is the extended M3U format encoded in UTF-8, also known as M3U8.
+var m3uFormat = playlistFormat{
+	name:        "M3U",
+	extension:   ".m3u8",
+	contentType: "audio/x-mpegurl",
+	writer:      func(w io.Writer, title string) songWriter { return model.NewM3UWriter(w, title) },
+	decode:      model.DecodeM3U,
+}
+
+// xspfFormat is the XML Shareable Playlist Format.
+var xspfFormat = playlistFormat{
+	name:        "XSPF",
+	extension:   ".xspf",
+	contentType: "application/xspf+xml",
+	writer:      func(w io.Writer, title string) songWriter { return model.NewXSPFWriter(w, title) },
+	decode:      model.DecodeXSPF,
+}
+
+// ExportM3U streams the songs matching the filters of the request as an extended M3U playlist encoded in UTF-8.
+// It takes a musicplaylist.ExportSongsRequest and the server stream as input.
+// It returns any error encountered while exporting.
+func (s *SongService) ExportM3U(req *musicplaylist.ExportSongsRequest, stream musicplaylist.SongApi_ExportM3UServer) error {
+	log.Printf("ExportM3U(%v) \n", req)
+	return s.exportFile(stream.Context(), req, stream, m3uFormat)
+}
+
+// ImportM3U stores the entries of an M3U or M3U8 playlist as songs.
+// Entries that are invalid or cannot be stored are reported in the summary with their position in the file.
+// It takes a context and a musicplaylist.ImportFileRequest as input.
+// It returns the summary of the import along with any error encountered.
+func (s *SongService) ImportM3U(ctx context.Context, req *musicplaylist.ImportFileRequest) (*musicplaylist.ImportSummary, error) {
+	log.Printf("ImportM3U(%d bytes, dry run %v) \n", len(req.GetContent()), req.GetDryRun())
+	return s.importFile(ctx, "ImportM3U", req, m3uFormat)
+}
+
+// ExportXSPF streams the songs matching the filters of the request as an XSPF playlist.
+// It takes a musicplaylist.ExportSongsRequest and the server stream as input.
+// It returns any error encountered while exporting.
+func (s *SongService) ExportXSPF(req *musicplaylist.ExportSongsRequest, stream musicplaylist.SongApi_ExportXSPFServer) error {
+	log.Printf("ExportXSPF(%v) \n", req)
+	return s.exportFile(stream.Context(), req, stream, xspfFormat)
+}
+
+// ImportXSPF stores the tracks of an XSPF playlist as songs.
+// Tracks that are invalid or cannot be stored are reported in the summary with their position in the file.
+// It takes a context and a musicplaylist.ImportFileRequest as input.
+// It returns the summary of the import along with any error encountered.
+func (s *SongService) ImportXSPF(ctx context.Context, req *musicplaylist.ImportFileRequest) (*musicplaylist.ImportSummary, error) {
+	log.Printf("ImportXSPF(%d bytes, dry run %v) \n", len(req.GetContent()), req.GetDryRun())
+	return s.importFile(ctx, "ImportXSPF", req, xspfFormat)
+}
+
+// exportFile streams the songs matching the filters of the request as a playlist file of the given format.
+// The songs are written to the stream while they are read from the repository.
+func (s *SongService) exportFile(ctx context.Context, req *musicplaylist.ExportSongsRequest, stream fileSender, format playlistFormat) error {
+	filter, err := toSongFilter(req)
+	if err != nil {
+		return err
+	}
+
+	out := newFileStream(stream, "songs"+format.extension, format.contentType)
+	w := format.writer(out, "")
+	err = s.repo.Each(ctx, filter, w.WriteSong)
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		err = out.Close()
+	}
+	if err != nil {
+		log.Printf("Fail Export%s %v \n", format.name, err)
+		return toStatus(err, "song", "")
+	}
+	return nil
+}
+
+// importFile stores the songs of a playlist file of the given format for the named RPC.
+// Entries without a length, such as "#EXTINF:-1" entries and bare URLs of M3U files, are reported as failures
+// explaining the length is missing, since every song needs a duration.
+func (s *SongService) importFile(ctx context.Context, rpc string, req *musicplaylist.ImportFileRequest, format playlistFormat) (*musicplaylist.ImportSummary, error) {
+	songs, err := format.decode(bytes.NewReader(req.GetContent()))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid %s file: %v", format.name, err)
+	}
+
+	imp := s.newImport(ctx, rpc, req.GetDryRun())
+	for i := range songs {
+		if songs[i].DurationMs <= 0 {
+			imp.reject(fmt.Sprintf("the %s file gives no length for %s, add its duration to import it",
+				format.name, entryName(&songs[i])))
+			continue
+		}
+		if err := imp.add(s.toSong(&songs[i])); err != nil {
+			return nil, err
+		}
+	}
+	return imp.finish()
+}
+
+// entryName returns how an entry of a playlist file is named in failures, by its title or else by its link.
+func entryName(song *model.Song) string {
+	switch {
+	case song.Title != "" && song.Artist != "":
+		return fmt.Sprintf("%q by %s", song.Title, song.Artist)
+	case song.Title != "":
+		return fmt.Sprintf("%q", song.Title)
+	}
+	return model.SongURL(song.Link)
+}
+
+// ExportM3U streams the tracks of a playlist, in order, as an extended M3U playlist encoded in UTF-8.
+// Tracks whose song was deleted are left out.
+// It takes a string value (playlist ID) and the server stream as input.
+// It returns any error encountered while exporting.
+func (s *PlaylistService) ExportM3U(id *wrappers.StringValue, stream musicplaylist.PlaylistApi_ExportM3UServer) error {
+	log.Printf("ExportM3U(%s) \n", id.GetValue())
+	return s.exportFile(id.GetValue(), stream, m3uFormat)
+}
+
+// ExportXSPF streams the tracks of a playlist, in order, as an XSPF playlist.
+// Tracks whose song was deleted are left out.
+// It takes a string value (playlist ID) and the server stream as input.
+// It returns any error encountered while exporting.
+func (s *PlaylistService) ExportXSPF(id *wrappers.StringValue, stream musicplaylist.PlaylistApi_ExportXSPFServer) error {
+	log.Printf("ExportXSPF(%s) \n", id.GetValue())
+	return s.exportFile(id.GetValue(), stream, xspfFormat)
+}
+
+// exportFile streams the tracks of a playlist as a playlist file of the given format.
+func (s *PlaylistService) exportFile(id string, stream fileSender, format playlistFormat) error {
+	playlist, err := s.repo.FindByID(id)
+	if err != nil {
+		log.Printf("%v", err)
+		return toStatus(err, "playlist", id)
+	}
+
+	out := newFileStream(stream, fileName(playlist.Name, "playlist")+format.extension, format.contentType)
+	w := format.writer(out, playlist.Name)
+	err = s.eachTrack(&playlist, w.WriteSong)
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		err = out.Close()
+	}
+	if err != nil {
+		log.Printf("Fail Export%s %v \n", format.name, err)
+		return toStatus(err, "playlist", id)
+	}
+	return nil
+}
+
+// eachTrack calls fn with the song of every track of a playlist, in order, skipping songs that no longer exist.
+func (s *PlaylistService) eachTrack(p *model.Playlist, fn func(model.Song) error) error {
+	for _, songID := range p.SongIDs {
+		song, err := s.songs.FindByID(songID.Hex())
+		if errors.Is(err, repository.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(song); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fileChunkSize is the size of the chunks of content sent by the exports of playlist files.
+const fileChunkSize = 32 * 1024
+
+// fileSender is the server stream of a playlist file export, such as musicplaylist.SongApi_ExportM3UServer.
+type fileSender interface {
+	Send(*musicplaylist.PlaylistFile) error
+}
+
+// fileStream sends the content written to it as chunks of a playlist file of at most fileChunkSize bytes.
+// The first chunk carries the name and media type of the file, Close sends the last chunk.
+type fileStream struct {
+	*bufio.Writer
+	chunks *fileChunks
+}
+
+// newFileStream creates a new instance of fileStream sending the file with the given name and media type on stream.
+func newFileStream(stream fileSender, name, contentType string) *fileStream {
+	chunks := &fileChunks{stream: stream, header: &musicplaylist.PlaylistFile{Name: name, ContentType: contentType}}
+	return &fileStream{Writer: bufio.NewWriterSize(chunks, fileChunkSize), chunks: chunks}
+}
+
+// Close sends the content still buffered, or the name and media type of the file if it is empty.
+func (f *fileStream) Close() error {
+	if err := f.Flush(); err != nil {
+		return err
+	}
+	if f.chunks.header != nil {
+		return f.chunks.stream.Send(f.chunks.header)
+	}
+	return nil
+}
+
+// fileChunks sends every write as a chunk of a playlist file.
+type fileChunks struct {
+	stream fileSender
+	header *musicplaylist.PlaylistFile // First message, until it is sent
+}
+
+// Write sends p as the next chunk of the file.
+func (c *fileChunks) Write(p []byte) (int, error) {
+	chunk := c.header
+	if chunk == nil {
+		chunk = &musicplaylist.PlaylistFile{}
+	}
+	chunk.Content = p
+	if err := c.stream.Send(chunk); err != nil {
+		return 0, err
+	}
+	c.header = nil
+	return len(p), nil
+}
+
+// unsafeFileChars matches the runs of characters that are replaced in file names.
+var unsafeFileChars = regexp.MustCompile(`[^\pL\pN._-]+`)
+
+// fileName turns a name into a file name without extension, using fallback for names without usable characters.
+func fileName(name, fallback string) string {
+	name = strings.Trim(unsafeFileChars.ReplaceAllString(name, "-"), "-.")
+	if name == "" {
+		return fallback
+	}
+	return name
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+)
+
+func TestImportM3UReportsEntriesWithoutLength(t *testing.T) {
+	content := "#EXTM3U\n" +
+		"#EXTINF:225,Artist - Title\nhttps://www.youtube.com/watch?v=a\n" +
+		"#EXTINF:-1,Someone - Unknown length\nhttps://www.youtube.com/watch?v=b\n" +
+		"https://www.youtube.com/watch?v=c\n" +
+		"#EXTINF:61,Band - Track\nhttps://www.youtube.com/watch?v=d\n"
+
+	for _, dryRun := range []bool{true, false} {
+		s := newTestSongService()
+		summary, err := s.ImportM3U(context.Background(), &musicplaylist.ImportFileRequest{Content: []byte(content), DryRun: dryRun})
+		if err != nil {
+			t.Fatalf("ImportM3U: %v", err)
+		}
+		if summary.Received != 4 || summary.Imported != 2 || len(summary.Failures) != 2 {
+			t.Fatalf("dry run %v: received %d, imported %d, failures %v, want 4 received, 2 imported and 2 failures",
+				dryRun, summary.Received, summary.Imported, summary.Failures)
+		}
+
+		want := []struct {
+			index int32
+			entry string
+		}{
+			{1, `"Unknown length" by Someone`},
+			{2, "https://www.youtube.com/watch?v=c"},
+		}
+		for i, w := range want {
+			f := summary.Failures[i]
+			if f.Index != w.index || !strings.Contains(f.Reason, "no length") || !strings.Contains(f.Reason, w.entry) {
+				t.Errorf("dry run %v: failure %d is %v, want entry %d (%s) without length", dryRun, i, f, w.index, w.entry)
+			}
+		}
+
+		stored := int64(0)
+		if !dryRun {
+			stored = 2
+		}
+		if n, _ := s.repo.Count(); n != stored {
+			t.Errorf("dry run %v: %d songs stored, want %d", dryRun, n, stored)
+		}
+	}
+}
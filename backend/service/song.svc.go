@@ -2,29 +2,46 @@ package service
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
 	"github.com/Dwiyasa-Nakula/master/backend/model"
 	"github.com/Dwiyasa-Nakula/master/backend/repository"
-	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+const (
+	defaultPageSize = 25  // Page size used when the request does not specify one
+	maxPageSize     = 100 // Largest page size a request may ask for
+)
+
 // SongService handles gRPC requests related to songs.
 type SongService struct {
 	musicplaylist.UnimplementedSongApiServer // Embed the generated gRPC server interface
-	repo *repository.SongRepository          // Repository to interact with the database
+	repo    repository.SongStore             // Repository to interact with the database
+	audit   repository.AuditStore            // Repository recording every change made to a song
+	events  *repository.SongEventBus         // Bus the changes made through the service are published on
+	watcher repository.SongWatcher           // Source of the changes streamed by WatchSongs
 }
 
 // NewSongService creates a new instance of SongService.
-func NewSongService(repo *repository.SongRepository) *SongService {
+// Changes made through the service are published on events, WatchSongs streams them from watcher,
+// which is either the same bus or a source seeing the changes of every server, such as MongoDB change streams.
+func NewSongService(repo repository.SongStore, audit repository.AuditStore, events *repository.SongEventBus, watcher repository.SongWatcher) *SongService {
 	return &SongService{
-		repo: repo,
+		repo:    repo,
+		audit:   audit,
+		events:  events,
+		watcher: watcher,
 	}
 }
 
@@ -34,40 +51,141 @@ func NewSongService(repo *repository.SongRepository) *SongService {
 func (s *SongService) CreateSong(ctx context.Context, tm *musicplaylist.Song) (*musicplaylist.Song, error) {
 	log.Printf("CreateSong(%v) \n", tm)
 
-	// Convert the received gRPC song to a model song
-	newSong := &model.Song{ 
-		Title:    	tm.Title,
-		Artist: 	tm.Artist,
-		Album: 		tm.Album,
-		Duration: 	tm.Duration,
-		Link:	 	tm.Link,
+	// Validate the received gRPC song and convert it to a model song
+	newSong, err := validateSong(tm)
+	if err != nil {
+		return nil, err
 	}
 
 	// Save the new song in the repository
 	song, err := s.repo.Save(newSong)
 	if err != nil {
 		log.Printf("%v", err)
-		return nil, err
+		return nil, toStatus(err, "song", newSong.ID.Hex())
 	}
+	s.record(ctx, "CreateSong", song.ID, nil, &song)
 
 	// Convert the model song back to a gRPC song and return
 	return s.toSong(&song), nil
 }
 
-// ListSongs retrieves a list of all songs.
-// It takes a context and an empty message as input.
-// It returns a list of songs along with any error encountered.
-func (s *SongService) ListSongs(ctx context.Context, e *empty.Empty) (*musicplaylist.SongList, error) {
-	log.Printf("ListSongs() \n")
+// GetSong retrieves a single song by its ID.
+// It takes a context and a string value (song ID) as input.
+// It returns the song along with any error encountered.
+func (s *SongService) GetSong(ctx context.Context, id *wrappers.StringValue) (*musicplaylist.Song, error) {
+	log.Printf("GetSong(%s) \n", id.GetValue())
+
+	// Retrieve the song from the repository
+	song, err := s.repo.FindByID(id.GetValue())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", id.GetValue())
+	}
+
+	// Convert the model song to a gRPC song and return
+	return s.toSong(&song), nil
+}
+
+// ListSongs retrieves a page of songs that are not in the trash.
+// It takes a context and a musicplaylist.ListSongsRequest carrying the page size and page token as input.
+// It returns a list of songs with the token of the next page along with any error encountered.
+func (s *SongService) ListSongs(ctx context.Context, req *musicplaylist.ListSongsRequest) (*musicplaylist.SongList, error) {
+	log.Printf("ListSongs(%v) \n", req)
+
+	SongList, err := s.listPage(req, s.repo.FindPage)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := s.repo.Count()
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", "")
+	}
+	SongList.TotalSize = total
 
-	// Retrieve all songs from the repository
+	return SongList, nil
+}
+
+// listPage retrieves the page of songs selected by req through find.
+// find is called with the ID of the last song of the previous page and the number of songs to return.
+// It returns a list of songs with the token of the next page along with any error encountered.
+func (s *SongService) listPage(req *musicplaylist.ListSongsRequest, find func(after string, limit int64) ([]model.Song, error)) (*musicplaylist.SongList, error) {
+	// Clamp the requested page size
+	pageSize := int64(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// Decode the page token into the ID of the last song of the previous page
+	after, err := decodePageToken(req.GetPageToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", req.GetPageToken())
+	}
+
+	// Retrieve one extra song to find out whether there is a next page
 	var totas []*musicplaylist.Song
-	Songs, err := s.repo.FindAll()
+	Songs, err := find(after, pageSize+1)
 	if err != nil {
 		log.Printf("%v", err)
+		if errors.Is(err, repository.ErrInvalidID) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q", req.GetPageToken())
+		}
+		return nil, toStatus(err, "song", after)
+	}
+
+	var nextPageToken string
+	if int64(len(Songs)) > pageSize {
+		Songs = Songs[:pageSize]
+		nextPageToken = encodePageToken(Songs[len(Songs)-1].ID.Hex())
+	}
+
+	// Convert each model song to a gRPC song
+	for _, u := range Songs {
+		totas = append(totas, s.toSong(&u))
+	}
+
+	// Create a gRPC song list and return
+	SongList := &musicplaylist.SongList{
+		List:          totas,
+		NextPageToken: nextPageToken,
+	}
+
+	return SongList, nil
+}
+
+// SearchSongs retrieves songs matching a free-text query and structured filters.
+// It takes a context and a musicplaylist.SearchSongsRequest as input.
+// It returns a list of matching songs along with any error encountered.
+func (s *SongService) SearchSongs(ctx context.Context, req *musicplaylist.SearchSongsRequest) (*musicplaylist.SongList, error) {
+	log.Printf("SearchSongs(%v) \n", req)
+
+	// Validate the filter
+	filter, err := toSongFilter(req)
+	if err != nil {
 		return nil, err
 	}
 
+	// Clamp the requested page size
+	pageSize := int64(req.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	// Search the songs in the repository
+	var totas []*musicplaylist.Song
+	Songs, err := s.repo.Search(filter, pageSize)
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", "")
+	}
+
 	// Convert each model song to a gRPC song
 	for _, u := range Songs {
 		totas = append(totas, s.toSong(&u))
@@ -75,63 +193,102 @@ func (s *SongService) ListSongs(ctx context.Context, e *empty.Empty) (*musicplay
 
 	// Create a gRPC song list and return
 	SongList := &musicplaylist.SongList{
-		List: totas,
+		List:      totas,
+		TotalSize: int64(len(totas)),
 	}
 
 	return SongList, nil
 }
 
 // UpdateSong updates an existing song.
-// It takes a context and a musicplaylist.Song as input.
-// It returns the updated song along with any error encountered.
-func (s *SongService) UpdateSong(ctx context.Context, tm *musicplaylist.Song) (*musicplaylist.Song, error) {
-	log.Printf("UpdateSong(%v) \n", tm)
+// It takes a context and a musicplaylist.UpdateSongRequest as input.
+// Only the fields listed in the update mask are changed, an empty mask replaces the whole song.
+// It returns the song as stored after the update along with any error encountered.
+func (s *SongService) UpdateSong(ctx context.Context, req *musicplaylist.UpdateSongRequest) (*musicplaylist.Song, error) {
+	log.Printf("UpdateSong(%v) \n", req)
 
 	// Check if the song ID is provided
-	if tm.Id == "" {
-		return nil, status.Error(codes.FailedPrecondition, "UpdateSong must provide songID")
+	tm := req.GetSong()
+	if tm.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "UpdateSong must provide songID")
+	}
+
+	// Check if the version the update is based on is provided
+	version := tm.GetVersion()
+	if version <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "UpdateSong must provide the song version")
 	}
 
 	// Convert the song ID to an ObjectID
 	songID, err := primitive.ObjectIDFromHex(tm.Id)
 	if err != nil {
 		log.Printf("Invalid SongID(%s) \n", tm.Id)
-		return nil, status.Errorf(codes.Internal, "%v", err)
+		return nil, toStatus(repository.ErrInvalidID, "song", tm.Id)
+	}
+
+	// Retrieve the stored song for the audit trail and the update mask
+	current, err := s.repo.FindByID(tm.Id)
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", tm.Id)
+	}
+	if current.Version != version {
+		return nil, toStatus(repository.ErrVersionConflict, "song", tm.Id)
 	}
 
-	// Create a model song with updated fields
-	updateSong := &model.Song{
-		ID:          songID,
-		Title:       tm.Title,
-		Artist: 	 tm.Artist,
-		Album: 		 tm.Album,
-		Duration: 	 tm.Duration,
-		Link: 		 tm.Link,
+	// Merge the masked fields into the stored song
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		tm, err = applyUpdateMask(s.toSong(&current), tm, paths)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	// Validate the received gRPC song and create a model song with updated fields
+	updateSong, err := validateSong(tm)
+	if err != nil {
+		return nil, err
+	}
+	updateSong.ID = songID
+	updateSong.Version = version
+
 	// Update the song in the repository
 	song, err := s.repo.Update(updateSong)
 	if err != nil {
 		log.Printf("Fail UpdateSong %v \n", err)
-		return nil, status.Errorf(codes.Internal, "%v", err)
+		return nil, toStatus(err, "song", tm.Id)
 	}
+	s.record(ctx, "UpdateSong", song.ID, &current, &song)
 
 	// Convert the updated model song back to a gRPC song and return
 	return s.toSong(&song), nil
 }
 
-// DeleteSong deletes an existing song.
-// It takes a context and a string value (song ID) as input.
+// DeleteSong moves an existing song to the trash, from where it can be restored until it is purged.
+// It takes a context and a musicplaylist.DeleteSongRequest carrying the song ID and version as input.
 // It returns a boolean indicating the deletion success along with any error encountered.
-func (s *SongService) DeleteSong(ctx context.Context, id *wrappers.StringValue) (*wrappers.BoolValue, error) {
-	log.Printf("DeleteSong(%s) \n", id.GetValue())
+func (s *SongService) DeleteSong(ctx context.Context, req *musicplaylist.DeleteSongRequest) (*wrappers.BoolValue, error) {
+	log.Printf("DeleteSong(%v) \n", req)
+
+	// Check if the version the deletion is based on is provided
+	if req.GetVersion() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "DeleteSong must provide the song version")
+	}
+
+	// Retrieve the stored song for the audit trail
+	current, err := s.repo.FindByID(req.GetId())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "song", req.GetId())
+	}
 
 	// Delete the song from the repository
-	deleted, err := s.repo.Delete(id.GetValue())
+	deleted, err := s.repo.Delete(req.GetId(), req.GetVersion())
 	if err != nil {
 		log.Printf("%v", err)
-		return nil, err
+		return nil, toStatus(err, "song", req.GetId())
 	}
+	s.record(ctx, "DeleteSong", current.ID, &current, nil)
 
 	// Return a boolean indicating the deletion success
 	return &wrapperspb.BoolValue{Value: deleted}, nil
@@ -146,7 +303,72 @@ func (s *SongService) toSong(u *model.Song) *musicplaylist.Song {
 		Artist: 	 u.Artist,
 		Album: 		 u.Album,
 		Duration: 	 u.Duration,
+		DurationMs:  u.DurationMs,
 		Link:	 	 u.Link,
+		Version:     u.Version,
+	}
+	if u.DeletedAt != nil {
+		tota.DeletedAt = timestamppb.New(*u.DeletedAt)
 	}
 	return tota
+}
+
+// applyUpdateMask copies the fields named in paths from src to dst.
+// It returns dst along with an InvalidArgument error for paths that do not name an updatable field.
+func applyUpdateMask(dst, src *musicplaylist.Song, paths []string) (*musicplaylist.Song, error) {
+	for _, path := range paths {
+		switch path {
+		case "title":
+			dst.Title = src.Title
+		case "artist":
+			dst.Artist = src.Artist
+		case "album":
+			dst.Album = src.Album
+		case "duration", "duration_ms":
+			// Either form of the duration replaces both, normalizeDuration picks the one that is set.
+			dst.Duration = src.Duration
+			dst.DurationMs = src.DurationMs
+		case "link":
+			dst.Link = src.Link
+		case "id", "version":
+			// Identify the stored song rather than change it, masks derived from a whole request body name them.
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q", path)
+		}
+	}
+	return dst, nil
+}
+
+// normalizeDuration returns the display string and the milliseconds of the duration of a song.
+// The duration string takes precedence and may be given as "3:45", "225" or "3m45s",
+// duration_ms is only used when the string is empty.
+func normalizeDuration(tm *musicplaylist.Song) (string, int64, error) {
+	if strings.TrimSpace(tm.Duration) == "" && tm.DurationMs > 0 {
+		d := time.Duration(tm.DurationMs) * time.Millisecond
+		return model.FormatDuration(d), tm.DurationMs, nil
+	}
+
+	d, err := model.ParseDuration(tm.Duration)
+	if err != nil {
+		return "", 0, status.Errorf(codes.InvalidArgument, "invalid duration %q, use e.g. \"3:45\", \"225\" or \"3m45s\"", tm.Duration)
+	}
+	return model.FormatDuration(d), d.Milliseconds(), nil
+}
+
+// encodePageToken turns the ID of the last song of a page into an opaque page token.
+func encodePageToken(lastID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastID))
+}
+
+// decodePageToken turns an opaque page token back into the ID of the last song of the previous page.
+// An empty token yields an empty ID, meaning the first page.
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	lastID, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", err
+	}
+	return string(lastID), nil
 }
\ No newline at end of file
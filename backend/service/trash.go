@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ListDeletedSongs retrieves a page of songs from the trash.
+// It takes a context and a musicplaylist.ListSongsRequest carrying the page size and page token as input.
+// It returns a list of songs with the token of the next page along with any error encountered, total_size is not set.
+func (s *SongService) ListDeletedSongs(ctx context.Context, req *musicplaylist.ListSongsRequest) (*musicplaylist.SongList, error) {
+	log.Printf("ListDeletedSongs(%v) \n", req)
+
+	return s.listPage(req, s.repo.FindDeleted)
+}
+
+// RestoreSong moves a song out of the trash.
+// It takes a context and a string value (song ID) as input.
+// It returns the restored song along with any error encountered.
+func (s *SongService) RestoreSong(ctx context.Context, id *wrappers.StringValue) (*musicplaylist.Song, error) {
+	log.Printf("RestoreSong(%s) \n", id.GetValue())
+
+	song, err := s.repo.Restore(id.GetValue())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "deleted song", id.GetValue())
+	}
+	s.record(ctx, "RestoreSong", song.ID, nil, &song)
+
+	return s.toSong(&song), nil
+}
+
+// PurgeSong permanently deletes a song from the trash.
+// It takes a context and a string value (song ID) as input.
+// It returns a boolean indicating the purge success along with any error encountered.
+func (s *SongService) PurgeSong(ctx context.Context, id *wrappers.StringValue) (*wrappers.BoolValue, error) {
+	log.Printf("PurgeSong(%s) \n", id.GetValue())
+
+	purged, err := s.repo.Purge(id.GetValue())
+	if err != nil {
+		log.Printf("%v", err)
+		return nil, toStatus(err, "deleted song", id.GetValue())
+	}
+	if songID, err := primitive.ObjectIDFromHex(id.GetValue()); err == nil {
+		s.record(ctx, "PurgeSong", songID, nil, nil)
+	}
+
+	return &wrapperspb.BoolValue{Value: purged}, nil
+}
+
+// systemActor is the actor of the changes made by the server itself, such as purging the trash.
+const systemActor = "system"
+
+// PurgeTrash permanently deletes the songs that have been in the trash for longer than retention.
+// It checks the trash right away and then every interval until ctx is done.
+// Every purged song is recorded in the audit trail as a PurgeTrash by the system actor.
+func PurgeTrash(ctx context.Context, repo repository.SongStore, audit repository.AuditStore, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := repo.PurgeDeletedBefore(time.Now().Add(-retention))
+		if err != nil {
+			log.Printf("Fail to purge trash: %v \n", err)
+		} else if len(purged) > 0 {
+			log.Printf("Purged %d songs from the trash \n", len(purged))
+		}
+		for _, id := range purged {
+			_, err := audit.Record(&model.SongAudit{
+				SongID: id,
+				Actor:  systemActor,
+				RPC:    "PurgeTrash",
+				Time:   time.Now().UTC(),
+			})
+			if err != nil {
+				log.Printf("Fail to record PurgeTrash of song %s: %v \n", id.Hex(), err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
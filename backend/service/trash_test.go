@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"github.com/Dwiyasa-Nakula/master/backend/repository"
+)
+
+func TestPurgeTrashRecordsAudit(t *testing.T) {
+	songs := repository.NewMemorySongRepo()
+	audits := repository.NewMemoryAuditRepo()
+	song, err := songs.Save(&model.Song{Title: "Title", Artist: "Artist", Duration: "3:00"})
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if _, err := songs.Delete(song.ID.Hex(), song.Version); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	// A done context makes PurgeTrash return after the first pass.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	time.Sleep(time.Millisecond)
+	PurgeTrash(ctx, songs, audits, 0, time.Hour)
+
+	if deleted, err := songs.FindDeleted("", 10); err != nil || len(deleted) != 0 {
+		t.Fatalf("trash after PurgeTrash is %v, %v, want empty", deleted, err)
+	}
+	entries, err := audits.FindBySong(song.ID.Hex())
+	if err != nil {
+		t.Fatalf("FindBySong: %v", err)
+	}
+	if len(entries) != 1 || entries[0].RPC != "PurgeTrash" || entries[0].Actor != systemActor {
+		t.Fatalf("audit trail is %+v, want one PurgeTrash by %s", entries, systemActor)
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Maximum lengths, in characters, of the text fields of a song.
+const (
+	maxTitleLength  = 200
+	maxArtistLength = 200
+	maxAlbumLength  = 200
+	maxLinkLength   = 500
+)
+
+// linkHosts lists the hosts accepted in song links, subdomains such as www. or m. are accepted too.
+var linkHosts = []string{"soundcloud.com", "snd.sc", "youtube.com", "youtu.be"}
+
+// validateSong checks the fields of a song received from a client and converts it to a model song.
+// Text fields are trimmed and the duration is normalized.
+// All problems are reported at once as a codes.InvalidArgument error carrying google.rpc.BadRequest field violations.
+func validateSong(tm *musicplaylist.Song) (*model.Song, error) {
+	song := &model.Song{
+		Title:  strings.TrimSpace(tm.Title),
+		Artist: strings.TrimSpace(tm.Artist),
+		Album:  strings.TrimSpace(tm.Album),
+		Link:   strings.TrimSpace(tm.Link),
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(field, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: description,
+		})
+	}
+
+	checkText := func(field, value string, maxLength int, required bool) {
+		switch {
+		case required && value == "":
+			violate(field, field+" is required")
+		case utf8.RuneCountInString(value) > maxLength:
+			violate(field, field+" must be at most "+strconv.Itoa(maxLength)+" characters")
+		}
+	}
+	checkText("title", song.Title, maxTitleLength, true)
+	checkText("artist", song.Artist, maxArtistLength, true)
+	checkText("album", song.Album, maxAlbumLength, false)
+	checkText("link", song.Link, maxLinkLength, true)
+	if song.Link != "" && len(song.Link) <= maxLinkLength && !validLink(song.Link) {
+		violate("link", "link must be a numeric SoundCloud track ID or a SoundCloud or YouTube URL")
+	}
+
+	duration, durationMs, err := normalizeDuration(tm)
+	if err != nil {
+		violate("duration", status.Convert(err).Message())
+	}
+	song.Duration = duration
+	song.DurationMs = durationMs
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, "invalid song").
+			WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid song")
+		}
+		return nil, st.Err()
+	}
+	return song, nil
+}
+
+// validLink reports whether link is a numeric SoundCloud track ID or an http(s) URL on a known host.
+func validLink(link string) bool {
+	if isDigits(link) {
+		return true
+	}
+
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, known := range linkHosts {
+		if host == known || strings.HasSuffix(host, "."+known) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
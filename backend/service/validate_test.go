@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validSong returns a song passing validation, tests change one field at a time.
+func validSong() *musicplaylist.Song {
+	return &musicplaylist.Song{
+		Title:    "Title",
+		Artist:   "Artist",
+		Album:    "Album",
+		Duration: "3:45",
+		Link:     "https://www.youtube.com/watch?v=dQw4w9WgXcQ",
+	}
+}
+
+// fieldViolations returns the BadRequest field violations of err, failing the test when err is not
+// a codes.InvalidArgument error carrying them.
+func fieldViolations(t *testing.T, err error) []*errdetails.BadRequest_FieldViolation {
+	t.Helper()
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("got %v (%v), want InvalidArgument", st.Code(), err)
+	}
+	for _, detail := range st.Details() {
+		if br, ok := detail.(*errdetails.BadRequest); ok {
+			return br.FieldViolations
+		}
+	}
+	t.Fatalf("error %v carries no BadRequest details", err)
+	return nil
+}
+
+func TestValidateSongReportsFieldViolations(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*musicplaylist.Song)
+		want   map[string]string // Field of each violation and a part of its description
+	}{
+		{"missing title", func(s *musicplaylist.Song) { s.Title = "" }, map[string]string{"title": "title is required"}},
+		{"blank artist", func(s *musicplaylist.Song) { s.Artist = "   " }, map[string]string{"artist": "artist is required"}},
+		{"missing link", func(s *musicplaylist.Song) { s.Link = "" }, map[string]string{"link": "link is required"}},
+		{"long title", func(s *musicplaylist.Song) { s.Title = strings.Repeat("a", maxTitleLength+1) }, map[string]string{"title": "at most 200 characters"}},
+		{"long artist", func(s *musicplaylist.Song) { s.Artist = strings.Repeat("é", maxArtistLength+1) }, map[string]string{"artist": "at most 200 characters"}},
+		{"long album", func(s *musicplaylist.Song) { s.Album = strings.Repeat("a", maxAlbumLength+1) }, map[string]string{"album": "at most 200 characters"}},
+		{"long link", func(s *musicplaylist.Song) { s.Link = "https://youtube.com/" + strings.Repeat("a", maxLinkLength) }, map[string]string{"link": "at most 500 characters"}},
+		{"unknown link host", func(s *musicplaylist.Song) { s.Link = "https://evil.example/song" }, map[string]string{"link": "SoundCloud or YouTube URL"}},
+		{"link without scheme", func(s *musicplaylist.Song) { s.Link = "youtube.com/watch?v=a" }, map[string]string{"link": "SoundCloud or YouTube URL"}},
+		{"bad duration", func(s *musicplaylist.Song) { s.Duration = "soon" }, map[string]string{"duration": `invalid duration "soon"`}},
+		{"zero duration", func(s *musicplaylist.Song) { s.Duration = "0:00" }, map[string]string{"duration": "invalid duration"}},
+		{"several violations", func(s *musicplaylist.Song) {
+			s.Title = ""
+			s.Artist = ""
+			s.Link = "ftp://soundcloud.com/a"
+			s.Duration = "3:75"
+		}, map[string]string{
+			"title":    "title is required",
+			"artist":   "artist is required",
+			"link":     "SoundCloud or YouTube URL",
+			"duration": `invalid duration "3:75"`,
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := validSong()
+			tt.change(tm)
+			song, err := validateSong(tm)
+			if song != nil {
+				t.Errorf("got song %v, want none", song)
+			}
+
+			got := map[string]string{}
+			for _, v := range fieldViolations(t, err) {
+				if _, dup := got[v.Field]; dup {
+					t.Errorf("field %s is reported more than once", v.Field)
+				}
+				got[v.Field] = v.Description
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("violations are %v, want fields %v", got, tt.want)
+			}
+			for field, part := range tt.want {
+				if !strings.Contains(got[field], part) {
+					t.Errorf("violation of %s is %q, want it to contain %q", field, got[field], part)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSongNormalizesFields(t *testing.T) {
+	tm := &musicplaylist.Song{
+		Title:    "  Title ",
+		Artist:   "Artist\t",
+		Duration: "225",
+		Link:     " 123456 ",
+	}
+	song, err := validateSong(tm)
+	if err != nil {
+		t.Fatalf("validateSong: %v", err)
+	}
+	if song.Title != "Title" || song.Artist != "Artist" || song.Album != "" || song.Link != "123456" {
+		t.Errorf("text fields are %q, %q, %q, %q, want them trimmed", song.Title, song.Artist, song.Album, song.Link)
+	}
+	if song.Duration != "3:45" || song.DurationMs != 225000 {
+		t.Errorf("duration is %q (%d ms), want 3:45 (225000 ms)", song.Duration, song.DurationMs)
+	}
+}
+
+func TestValidLink(t *testing.T) {
+	tests := map[string]bool{
+		"123456":                                   true,
+		"https://soundcloud.com/artist/track":      true,
+		"https://m.soundcloud.com/artist/track":    true,
+		"https://snd.sc/abc":                       true,
+		"http://www.youtube.com/watch?v=a":         true,
+		"https://YOUTU.BE/a":                       true,
+		"https://music.youtube.com/watch?v=a":      true,
+		"":                                         false,
+		"12a456":                                   false,
+		"javascript:alert(1)":                      false,
+		"ftp://soundcloud.com/a":                   false,
+		"//youtube.com/watch?v=a":                  false,
+		"https://notyoutube.com/watch?v=a":         false,
+		"https://youtube.com.evil.example/watch":   false,
+		"https://evil.example/?u=https://youtu.be": false,
+	}
+	for link, want := range tests {
+		if got := validLink(link); got != want {
+			t.Errorf("validLink(%q) = %v, want %v", link, got, want)
+		}
+	}
+}
+
+func TestCreateSongReturnsFieldViolations(t *testing.T) {
+	s := newTestSongService()
+	tm := validSong()
+	tm.Artist = ""
+	tm.Duration = "later"
+	_, err := s.CreateSong(context.Background(), tm)
+
+	var fields []string
+	for _, v := range fieldViolations(t, err) {
+		fields = append(fields, v.Field)
+	}
+	if want := []string{"artist", "duration"}; !reflect.DeepEqual(fields, want) {
+		t.Errorf("violated fields are %v, want %v", fields, want)
+	}
+	if n, _ := s.repo.Count(); n != 0 {
+		t.Errorf("%d songs stored, want none", n)
+	}
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/Dwiyasa-Nakula/master/backend/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatchSongs streams the songs created, updated and deleted after the request, or after the event
+// identified by the resume token of the request, until the caller cancels the stream.
+// It takes a musicplaylist.WatchSongsRequest and the server stream as input.
+// It returns any error encountered while watching.
+func (s *SongService) WatchSongs(req *musicplaylist.WatchSongsRequest, stream musicplaylist.SongApi_WatchSongsServer) error {
+	log.Printf("WatchSongs(%v) \n", req)
+
+	err := s.watcher.Watch(stream.Context(), req.GetResumeToken(), func(e model.SongEvent) error {
+		return stream.Send(s.toSongEvent(&e))
+	})
+	// The caller went away, there is nobody left to report to
+	if stream.Context().Err() != nil {
+		return nil
+	}
+	if err != nil {
+		log.Printf("Fail WatchSongs %v \n", err)
+		return toStatus(err, "song event", req.GetResumeToken())
+	}
+	return status.Error(codes.Unavailable, "song watcher stopped")
+}
+
+// publish delivers the change between two snapshots of a song to the watchers of the event bus.
+// A song without a snapshot before the change was created or restored, one without a snapshot after it was deleted.
+func (s *SongService) publish(before, after *model.Song) {
+	if s.events == nil {
+		return
+	}
+
+	e := model.SongEvent{Time: time.Now().UTC()}
+	switch {
+	case before == nil && after == nil:
+		// Purged songs were already reported when moved to the trash
+		return
+	case before == nil:
+		e.Type, e.Song = model.SongCreated, *after
+	case after == nil:
+		e.Type, e.Song = model.SongDeleted, *before
+	default:
+		e.Type, e.Song = model.SongUpdated, *after
+	}
+	s.events.Publish(e)
+}
+
+// toSongEvent converts a model.SongEvent to a musicplaylist.SongEvent.
+// It takes a model event as input and returns the equivalent gRPC event.
+func (s *SongService) toSongEvent(e *model.SongEvent) *musicplaylist.SongEvent {
+	return &musicplaylist.SongEvent{
+		Type:        songEventTypes[e.Type],
+		Song:        s.toSong(&e.Song),
+		ResumeToken: e.ResumeToken,
+		Time:        timestamppb.New(e.Time),
+	}
+}
+
+// songEventTypes maps the model event types to their gRPC counterparts.
+var songEventTypes = map[model.SongEventType]musicplaylist.SongEvent_Type{
+	model.SongCreated: musicplaylist.SongEvent_CREATED,
+	model.SongUpdated: musicplaylist.SongEvent_UPDATED,
+	model.SongDeleted: musicplaylist.SongEvent_DELETED,
+}
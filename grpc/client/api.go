@@ -0,0 +1,330 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// apiPrefix is the path under which the JSON API is served.
+const apiPrefix = "/api/v1"
+
+// maxAPIBodySize is the largest request body accepted by the JSON API.
+const maxAPIBodySize = 1 << 20
+
+// apiMarshal holds the options used to write messages of the JSON API.
+// Field names follow the proto definition and zero values are written, so every field is always present.
+var apiMarshal = protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}
+
+// registerAPI registers the handlers of the JSON API on mux.
+func (s *httpServer) registerAPI(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+apiPrefix+"/songs", s.handleAPIListSongs)
+	mux.HandleFunc("POST "+apiPrefix+"/songs", s.handleAPICreateSong)
+	mux.HandleFunc("GET "+apiPrefix+"/songs/{id}", s.handleAPIGetSong)
+	mux.HandleFunc("PUT "+apiPrefix+"/songs/{id}", s.handleAPIReplaceSong)
+	mux.HandleFunc("PATCH "+apiPrefix+"/songs/{id}", s.handleAPIPatchSong)
+	mux.HandleFunc("DELETE "+apiPrefix+"/songs/{id}", s.handleAPIDeleteSong)
+	mux.HandleFunc(apiPrefix+"/songs", handleAPIMethodNotAllowed("GET, HEAD, POST"))
+	mux.HandleFunc(apiPrefix+"/songs/{id}", handleAPIMethodNotAllowed("GET, HEAD, PUT, PATCH, DELETE"))
+	mux.HandleFunc(apiPrefix+"/", handleAPINotFound)
+}
+
+// handleAPIMethodNotAllowed returns the handler answering 405 Method Not Allowed to the methods a path of the API
+// does not serve, with the methods it serves in the Allow header.
+func handleAPIMethodNotAllowed(allow string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Allow", allow)
+		writeAPIErrorCode(w, http.StatusMethodNotAllowed,
+			status.Errorf(codes.Unimplemented, "%s is not allowed on %s, use %s", r.Method, r.URL.Path, allow))
+	}
+}
+
+// handleAPINotFound answers requests for paths and methods the JSON API does not serve,
+// so they get a JSON error instead of the HTML index page.
+func handleAPINotFound(w http.ResponseWriter, r *http.Request) {
+	writeAPIError(w, status.Errorf(codes.NotFound, "%s %s is not part of the API", r.Method, r.URL.Path))
+}
+
+// handleAPIListSongs returns a page of songs.
+// The q, artist, album, min_duration_ms and max_duration_ms parameters search the catalog through SearchSongs,
+// without them the songs are listed through ListSongs using the page_size and page_token parameters.
+func (s *httpServer) handleAPIListSongs(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	pageSize, err := intParam(query.Get("page_size"))
+	if err != nil {
+		writeAPIError(w, status.Errorf(codes.InvalidArgument, "invalid page_size: %v", err))
+		return
+	}
+	minDuration, err := intParam(query.Get("min_duration_ms"))
+	if err != nil {
+		writeAPIError(w, status.Errorf(codes.InvalidArgument, "invalid min_duration_ms: %v", err))
+		return
+	}
+	maxDuration, err := intParam(query.Get("max_duration_ms"))
+	if err != nil {
+		writeAPIError(w, status.Errorf(codes.InvalidArgument, "invalid max_duration_ms: %v", err))
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	search := &musicplaylist.SearchSongsRequest{
+		Query:         query.Get("q"),
+		Artist:        query.Get("artist"),
+		Album:         query.Get("album"),
+		MinDurationMs: minDuration,
+		MaxDurationMs: maxDuration,
+		PageSize:      int32(pageSize),
+	}
+	var songs *musicplaylist.SongList
+	if search.Query != "" || search.Artist != "" || search.Album != "" || minDuration > 0 || maxDuration > 0 {
+		songs, err = s.songs.SearchSongs(ctx, search)
+	} else {
+		songs, err = s.songs.ListSongs(ctx, &musicplaylist.ListSongsRequest{
+			PageSize:  int32(pageSize),
+			PageToken: query.Get("page_token"),
+		})
+	}
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIMessage(w, http.StatusOK, songs)
+}
+
+// handleAPICreateSong creates the song given in the request body.
+// It answers 201 Created with the stored song and its URL in the Location header.
+func (s *httpServer) handleAPICreateSong(w http.ResponseWriter, r *http.Request) {
+	song := &musicplaylist.Song{}
+	if _, err := readAPIMessage(w, r, song); err != nil {
+		writeAPIError(w, err)
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	created, err := s.songs.CreateSong(ctx, song)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	w.Header().Set("Location", apiPrefix+"/songs/"+created.GetId())
+	writeAPIMessage(w, http.StatusCreated, created)
+}
+
+// handleAPIGetSong returns the song given by the id in the path.
+// The version of the song is sent as ETag so it can be used in the If-Match header of later changes.
+func (s *httpServer) handleAPIGetSong(w http.ResponseWriter, r *http.Request) {
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	song, err := s.songs.GetSong(ctx, &wrapperspb.StringValue{Value: r.PathValue("id")})
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	writeAPIMessage(w, http.StatusOK, song)
+}
+
+// handleAPIReplaceSong replaces every field of the song given by the id in the path with the request body.
+func (s *httpServer) handleAPIReplaceSong(w http.ResponseWriter, r *http.Request) {
+	song := &musicplaylist.Song{}
+	if _, err := readAPIMessage(w, r, song); err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	s.updateAPISong(w, r, &musicplaylist.UpdateSongRequest{Song: song})
+}
+
+// handleAPIPatchSong changes the song given by the id in the path.
+// Only the fields present in the request body are changed.
+func (s *httpServer) handleAPIPatchSong(w http.ResponseWriter, r *http.Request) {
+	song := &musicplaylist.Song{}
+	fields, err := readAPIMessage(w, r, song)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+
+	// The identity and the version of the song are not fields to change.
+	mask := &fieldmaskpb.FieldMask{}
+	for _, field := range fields {
+		if field != "id" && field != "version" {
+			mask.Paths = append(mask.Paths, field)
+		}
+	}
+	if len(mask.Paths) == 0 {
+		writeAPIError(w, status.Error(codes.InvalidArgument, "request body must contain a field to change"))
+		return
+	}
+	s.updateAPISong(w, r, &musicplaylist.UpdateSongRequest{Song: song, UpdateMask: mask})
+}
+
+// updateAPISong sends an UpdateSong request for the song given by the id in the path and writes the updated song.
+// The version the change is based on is taken from the If-Match header, or from the body when the header is not set.
+func (s *httpServer) updateAPISong(w http.ResponseWriter, r *http.Request, req *musicplaylist.UpdateSongRequest) {
+	version, err := ifMatchVersion(r, req.Song.GetVersion())
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+	req.Song.Id = r.PathValue("id")
+	req.Song.Version = version
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	song, err := s.songs.UpdateSong(ctx, req)
+	if err != nil {
+		writeAPIChangeError(w, r, err)
+		return
+	}
+	writeAPIMessage(w, http.StatusOK, song)
+}
+
+// handleAPIDeleteSong moves the song given by the id in the path to the trash.
+// The version of the song is taken from the If-Match header or the version parameter.
+// It answers 204 No Content when the song was deleted.
+func (s *httpServer) handleAPIDeleteSong(w http.ResponseWriter, r *http.Request) {
+	version, err := intParam(r.URL.Query().Get("version"))
+	if err != nil {
+		writeAPIError(w, status.Errorf(codes.InvalidArgument, "invalid version: %v", err))
+		return
+	}
+	version, err = ifMatchVersion(r, version)
+	if err != nil {
+		writeAPIError(w, err)
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	_, err = s.songs.DeleteSong(ctx, &musicplaylist.DeleteSongRequest{Id: r.PathValue("id"), Version: version})
+	if err != nil {
+		writeAPIChangeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// readAPIMessage decodes the JSON request body into m.
+// It returns the proto names of the fields present in the body,
+// or an InvalidArgument error when the body is not a valid JSON encoding of m.
+func readAPIMessage(w http.ResponseWriter, r *http.Request, m proto.Message) ([]string, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxAPIBodySize))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not read request body: %v", err)
+	}
+	if err := protojson.Unmarshal(body, m); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+
+	// protojson accepts both the JSON and the proto name of a field, report the proto name.
+	var present map[string]json.RawMessage
+	if err := json.Unmarshal(body, &present); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request body: %v", err)
+	}
+	fields := m.ProtoReflect().Descriptor().Fields()
+	var names []string
+	for key := range present {
+		field := fields.ByJSONName(key)
+		if field == nil {
+			field = fields.ByTextName(key)
+		}
+		if field != nil {
+			names = append(names, string(field.Name()))
+		}
+	}
+	return names, nil
+}
+
+// writeAPIMessage writes m as the JSON response body with the given HTTP status code.
+func writeAPIMessage(w http.ResponseWriter, code int, m proto.Message) {
+	data, err := apiMarshal.Marshal(m)
+	if err != nil {
+		writeAPIError(w, status.Errorf(codes.Internal, "could not encode response: %v", err))
+		return
+	}
+	if song, ok := m.(*musicplaylist.Song); ok {
+		w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(song.GetVersion(), 10)))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// writeAPIError writes the gRPC status of err as the JSON response body.
+// The HTTP status code is derived from the gRPC status code, field violations are kept in the details.
+func writeAPIError(w http.ResponseWriter, err error) {
+	writeAPIErrorCode(w, httpStatus(err), err)
+}
+
+// writeAPIChangeError writes the error of a change made to a given version of a song.
+// A version conflict answers 412 Precondition Failed when the version came from the If-Match header,
+// and 409 Conflict like other errors when it came from the body or the version parameter.
+func writeAPIChangeError(w http.ResponseWriter, r *http.Request, err error) {
+	if status.Code(err) == codes.Aborted && r.Header.Get("If-Match") != "" {
+		writeAPIErrorCode(w, http.StatusPreconditionFailed, err)
+		return
+	}
+	writeAPIError(w, err)
+}
+
+// writeAPIErrorCode writes the gRPC status of err as the JSON response body with the given HTTP status code.
+func writeAPIErrorCode(w http.ResponseWriter, code int, err error) {
+	data, merr := apiMarshal.Marshal(status.Convert(err).Proto())
+	if merr != nil {
+		log.Printf("Failed to encode API error: %v\n", merr)
+		http.Error(w, http.StatusText(code), code)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+// ifMatchVersion returns the song version given in the If-Match header, or version when the header is not set.
+// It returns an InvalidArgument error when the header does not hold a version.
+func ifMatchVersion(r *http.Request, version int64) (int64, error) {
+	header := r.Header.Get("If-Match")
+	if header == "" {
+		return version, nil
+	}
+	tag := strings.TrimPrefix(strings.TrimSpace(header), "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		unquoted = tag
+	}
+	v, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "invalid If-Match header %q", header)
+	}
+	return v, nil
+}
+
+// intParam parses the integer query parameter value, an empty value is 0.
+func intParam(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
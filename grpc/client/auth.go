@@ -0,0 +1,333 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/authz"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// sessionCookie is the name of the cookie holding the session of a signed in user.
+const sessionCookie = "session"
+
+// Defaults of the authentication settings missing from the configuration.
+const (
+	defaultSessionTTL = 12 * time.Hour
+	defaultTokenTTL   = 5 * time.Minute
+)
+
+// clientAuth signs users in and mints the tokens forwarded to the gRPC server on their behalf.
+type clientAuth struct {
+	users         map[string]user // Users allowed to sign in, by name
+	policy        authz.Policy    // Roles allowed to call each RPC, to hide the actions a user may not take
+	sessionSecret []byte          // Secret signing the session cookies
+	sessionTTL    time.Duration
+	method        jwt.SigningMethod // Signing method of the tokens forwarded to the gRPC server
+	key           interface{}       // Key signing the tokens forwarded to the gRPC server
+	issuer        string
+	audience      string
+	tokenTTL      time.Duration
+}
+
+// user is a user allowed to sign in to the HTTP client.
+type user struct {
+	Name     string
+	Password string
+	Roles    []string // Roles forwarded to the gRPC server in the tokens of the user
+}
+
+// newClientAuth creates the authentication of the HTTP client from the app.auth settings.
+// Tokens are signed with the PEM private key in the file at app.auth.jwt.rsa_private_key when set,
+// and with the secret at app.auth.jwt.hmac_secret shared with the gRPC server otherwise.
+// The users allowed to sign in are listed in app.auth.users, and the overrides of the default policy of the gRPC server
+// in app.authz.policy.
+// The password of a user left empty in the config is read from the MUSICPLAYLIST_PASSWORD_<NAME> environment variable,
+// users without a password cannot sign in.
+// It returns an error when a secret or password is still a placeholder.
+func newClientAuth() (*clientAuth, error) {
+	auth := &clientAuth{
+		users:         make(map[string]user),
+		sessionSecret: []byte(viper.GetString("app.auth.session.secret")),
+		sessionTTL:    durationSetting("app.auth.session.ttl", defaultSessionTTL),
+		issuer:        viper.GetString("app.auth.jwt.issuer"),
+		audience:      viper.GetString("app.auth.jwt.audience"),
+		tokenTTL:      durationSetting("app.auth.jwt.token_ttl", defaultTokenTTL),
+	}
+
+	// Pick the key signing the tokens.
+	if path := viper.GetString("app.auth.jwt.rsa_private_key"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		auth.key, err = jwt.ParseRSAPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		auth.method = jwt.SigningMethodRS256
+	} else {
+		secret := viper.GetString("app.auth.jwt.hmac_secret")
+		if err := authz.CheckSecret("JWT secret", secret); err != nil {
+			return nil, err
+		}
+		auth.key = []byte(secret)
+		auth.method = jwt.SigningMethodHS256
+	}
+
+	// Sessions do not survive a restart when no secret is configured.
+	if len(auth.sessionSecret) > 0 {
+		if err := authz.CheckSecret("session secret", string(auth.sessionSecret)); err != nil {
+			return nil, err
+		}
+	} else {
+		auth.sessionSecret = make([]byte, 32)
+		if _, err := rand.Read(auth.sessionSecret); err != nil {
+			return nil, err
+		}
+	}
+
+	var users []user
+	if err := viper.UnmarshalKey("app.auth.users", &users); err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if u.Password == "" {
+			u.Password = authz.SecretFromEnv("MUSICPLAYLIST_PASSWORD_", u.Name)
+		}
+		if u.Password == "" {
+			log.Printf("Skipping user %s: no password configured \n", u.Name)
+			continue
+		}
+		if err := authz.CheckSecret("password of "+u.Name, u.Password); err != nil {
+			return nil, err
+		}
+		auth.users[u.Name] = u
+	}
+
+	var rules []authz.Rule
+	if err := viper.UnmarshalKey("app.authz.policy", &rules); err != nil {
+		return nil, err
+	}
+	auth.policy = authz.DefaultPolicy().Override(rules)
+	return auth, nil
+}
+
+// checkPassword reports whether password is the password of the user.
+func (a *clientAuth) checkPassword(name, password string) bool {
+	u, ok := a.users[name]
+	return ok && subtle.ConstantTimeCompare([]byte(password), []byte(u.Password)) == 1
+}
+
+// allows reports whether the request may call the RPC, such as SongApi/DeleteSong, to hide the actions the caller may not take.
+// Callers sending their own Authorization header are not known to the client and are shown every action.
+func (a *clientAuth) allows(r *http.Request, rpc string) bool {
+	if r.Header.Get("Authorization") != "" {
+		return true
+	}
+	name, ok := a.sessionUser(r)
+	return ok && a.policy.Allows(rpc, a.users[name].Roles)
+}
+
+// newSession returns the cookie holding a new session of the user.
+func (a *clientAuth) newSession(name string) (*http.Cookie, error) {
+	expires := time.Now().Add(a.sessionTTL)
+	value, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   name,
+		ExpiresAt: jwt.NewNumericDate(expires),
+	}).SignedString(a.sessionSecret)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Cookie{
+		Name:     sessionCookie,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}, nil
+}
+
+// sessionUser returns the user signed in with the session cookie of the request.
+// It returns false when the request has no valid session or the user is no longer allowed to sign in.
+func (a *clientAuth) sessionUser(r *http.Request) (string, bool) {
+	cookie, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return "", false
+	}
+	claims := &jwt.RegisteredClaims{}
+	_, err = jwt.ParseWithClaims(cookie.Value, claims, func(*jwt.Token) (interface{}, error) {
+		return a.sessionSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return "", false
+	}
+	if _, ok := a.users[claims.Subject]; !ok {
+		return "", false
+	}
+	return claims.Subject, true
+}
+
+// token returns a short-lived token identifying the user and their roles to the gRPC server.
+func (a *clientAuth) token(name string) (string, error) {
+	now := time.Now()
+	claims := authz.Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   name,
+			Issuer:    a.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(a.tokenTTL)),
+		},
+		Roles: a.users[name].Roles,
+	}
+	if a.audience != "" {
+		claims.Audience = jwt.ClaimStrings{a.audience}
+	}
+	return jwt.NewWithClaims(a.method, claims).SignedString(a.key)
+}
+
+// authenticate wraps next so every gRPC call made for a request carries the credentials of the caller.
+// The Authorization header of a request, such as the API key of a script using the JSON API, is forwarded unchanged.
+// Otherwise the user signed in with the session cookie is forwarded as a token derived from the session.
+// Requests without credentials are sent to the sign in page, or answered with 401 by the JSON API and the event stream.
+func (s *httpServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/login" || r.URL.Path == "/logout" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			name, ok := s.auth.sessionUser(r)
+			if !ok {
+				if strings.HasPrefix(r.URL.Path, apiPrefix+"/") || r.URL.Path == "/events" {
+					writeAPIError(w, status.Error(codes.Unauthenticated, "sign in or send a bearer token"))
+					return
+				}
+				http.Redirect(w, r, "/login?"+url.Values{"next": {r.URL.RequestURI()}}.Encode(), http.StatusSeeOther)
+				return
+			}
+			token, err := s.auth.token(name)
+			if err != nil {
+				log.Printf("Failed to sign token: %v\n", err)
+				http.Error(w, "Could not sign in to the server", http.StatusInternalServerError)
+				return
+			}
+			header = "Bearer " + token
+		}
+
+		ctx := metadata.AppendToOutgoingContext(r.Context(), "authorization", header)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// handleLogin shows the sign in form and starts a session for the user when the form is submitted.
+// The next parameter is the page shown after signing in.
+func (s *httpServer) handleLogin(w http.ResponseWriter, r *http.Request) {
+	next := localRedirect(r.FormValue("next"))
+
+	if r.Method != http.MethodPost {
+		renderLogin(w, next, "")
+		return
+	}
+
+	// Check the credentials and start the session.
+	user := r.FormValue("user")
+	if !s.auth.checkPassword(user, r.FormValue("password")) {
+		w.WriteHeader(http.StatusUnauthorized)
+		renderLogin(w, next, "Unknown user or wrong password")
+		return
+	}
+	cookie, err := s.auth.newSession(user)
+	if err != nil {
+		log.Printf("Failed to start session: %v\n", err)
+		http.Error(w, "Could not start session", http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(w, cookie)
+
+	// Redirect to the page that asked for signing in.
+	http.Redirect(w, r, next, http.StatusSeeOther)
+}
+
+// localRedirect returns next when it is a path on this site and "/playlist" otherwise.
+// Browsers treat "//host" and "/\host" as URLs on another host and drop tabs and newlines from URLs,
+// so next must start with a single slash followed by neither a slash nor a backslash, and must not contain control characters.
+func localRedirect(next string) string {
+	const fallback = "/playlist"
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return fallback
+	}
+	if strings.IndexFunc(next, func(r rune) bool { return r < ' ' || r == 0x7f }) >= 0 {
+		return fallback
+	}
+	if u, err := url.Parse(next); err != nil || u.Scheme != "" || u.Host != "" {
+		return fallback
+	}
+	return next
+}
+
+// handleLogout ends the session of the user and redirects to the sign in page.
+func (s *httpServer) handleLogout(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Path: "/", MaxAge: -1})
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+// renderLogin displays the sign in form with an optional error message.
+func renderLogin(w http.ResponseWriter, next, message string) {
+	type ViewData struct {
+		Next    string
+		Message string
+	}
+
+	tmpl := template.Must(template.New("login").Parse(loginTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+	err := tmpl.Execute(w, ViewData{Next: next, Message: message})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// loginTemplate defines the HTML template for the sign in form.
+var loginTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Sign In - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>Sign In</h1>
+    {{with .Message}}<p class="error-message">{{.}}</p>{{end}}
+    <form action="/login" method="post" class="grid-form">
+        <input type="hidden" name="next" value="{{.Next}}">
+        <div class="form-group">
+            <label for="user">User:</label>
+            <input type="text" id="user" name="user" required autofocus>
+        </div>
+        <div class="form-group">
+            <label for="password">Password:</label>
+            <input type="password" id="password" name="password" required>
+        </div>
+        <div class="form-group submit-group">
+            <input type="submit" value="Sign In">
+        </div>
+    </form>
+</div>
+</body>
+</html>`
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+)
+
+// Defaults of the gRPC client settings missing from the configuration.
+const (
+	defaultCallTimeout      = 10 * time.Second
+	defaultKeepaliveTime    = 30 * time.Second
+	defaultKeepaliveTimeout = 10 * time.Second
+	defaultRetryAttempts    = 4
+	defaultInitialBackoff   = 100 * time.Millisecond
+	defaultMaxBackoff       = 2 * time.Second
+)
+
+// dialGRPC creates the connection to the gRPC server shared by all HTTP requests.
+// The server is app.grpc.target, or localhost at app.grpc.port when no target is set.
+// Idle connections are kept alive with pings every app.grpc.keepalive.time, and calls failing with
+// UNAVAILABLE are retried up to app.grpc.retry.max_attempts times with exponential backoff.
+// The connection is established lazily and re-established by gRPC whenever it breaks.
+func dialGRPC() (*grpc.ClientConn, error) {
+	target := viper.GetString("app.grpc.target")
+	if target == "" {
+		target = net.JoinHostPort("localhost", viper.GetString("app.grpc.port"))
+	}
+
+	serviceConfig, err := retryServiceConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc.NewClient(target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                durationSetting("app.grpc.keepalive.time", defaultKeepaliveTime),
+			Timeout:             durationSetting("app.grpc.keepalive.timeout", defaultKeepaliveTimeout),
+			PermitWithoutStream: true,
+		}),
+		grpc.WithDefaultServiceConfig(serviceConfig),
+	)
+}
+
+// retryableMethods lists, by service, the methods the retry policy applies to.
+// They only read, so retrying a call that may have reached the server cannot store or change anything twice.
+var retryableMethods = map[string][]string{
+	musicplaylist.SongApi_ServiceDesc.ServiceName: {
+		"GetSong", "ListSongs", "SearchSongs", "ListDeletedSongs", "ListSongHistory",
+		"WatchSongs", "ExportSongs", "ExportM3U", "ExportXSPF", "CheckDuplicates",
+	},
+	musicplaylist.PlaylistApi_ServiceDesc.ServiceName: {
+		"GetPlaylist", "ListPlaylists", "ExportM3U", "ExportXSPF",
+	},
+}
+
+// retryServiceConfig returns the gRPC service config applying the configured retry policy to the retryableMethods.
+func retryServiceConfig() (string, error) {
+	attempts := viper.GetInt("app.grpc.retry.max_attempts")
+	if attempts <= 0 {
+		attempts = defaultRetryAttempts
+	}
+
+	var names []map[string]string
+	for service, methods := range retryableMethods {
+		for _, method := range methods {
+			names = append(names, map[string]string{"service": service, "method": method})
+		}
+	}
+	config := map[string]interface{}{
+		"methodConfig": []map[string]interface{}{{
+			"name": names,
+			"retryPolicy": map[string]interface{}{
+				"maxAttempts":          attempts,
+				"initialBackoff":       serviceConfigDuration(durationSetting("app.grpc.retry.initial_backoff", defaultInitialBackoff)),
+				"maxBackoff":           serviceConfigDuration(durationSetting("app.grpc.retry.max_backoff", defaultMaxBackoff)),
+				"backoffMultiplier":    2,
+				"retryableStatusCodes": []string{"UNAVAILABLE"},
+			},
+		}},
+	}
+	data, err := json.Marshal(config)
+	return string(data), err
+}
+
+// serviceConfigDuration formats a duration the way the gRPC service config expects it, in seconds such as "0.1s".
+func serviceConfigDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
+
+// durationSetting returns the duration set at key in the configuration, or fallback when it is not set.
+func durationSetting(key string, fallback time.Duration) time.Duration {
+	if d := viper.GetDuration(key); d > 0 {
+		return d
+	}
+	return fallback
+}
+
+// requestContext returns the context of the gRPC calls made for an HTTP request.
+// The calls are cancelled when the browser goes away and time out after the configured deadline.
+func (s *httpServer) requestContext(r *http.Request) (context.Context, context.CancelFunc) {
+	timeout := s.timeout
+	if timeout <= 0 {
+		timeout = defaultCallTimeout
+	}
+	return context.WithTimeout(r.Context(), timeout)
+}
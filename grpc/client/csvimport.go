@@ -0,0 +1,394 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/csv"
+	"errors"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+)
+
+// csvPreviewRows is the number of rows shown in the preview of a CSV import.
+const csvPreviewRows = 5
+
+// maxCSVFileSize is the largest CSV file, in bytes, accepted by the CSV import.
+const maxCSVFileSize = 2 << 20
+
+// songFields lists the song fields CSV columns can be mapped onto, in the order they are shown.
+var songFields = []string{"title", "artist", "album", "duration", "link"}
+
+// columnSynonyms lists words found in the column headers of each song field, used to guess the mapping.
+var columnSynonyms = map[string][]string{
+	"title":    {"title", "track", "song", "name"},
+	"artist":   {"artist", "creator", "performer", "band"},
+	"album":    {"album", "release", "record"},
+	"duration": {"duration", "length", "time"},
+	"link":     {"link", "url", "location", "soundcloud", "youtube"},
+}
+
+// csvUpload is an uploaded CSV file, read in full so it can be mapped and reviewed in several steps.
+type csvUpload struct {
+	Data   string     // The file, base64 encoded, carried from step to step in a hidden field
+	Header []string   // Column headers
+	Rows   [][]string // Data rows
+	Lines  []int      // Line of the file every data row starts on
+}
+
+// csvRow is a data row of a CSV import mapped onto a song, as shown in the preview and the review.
+type csvRow struct {
+	Index     int
+	Line      int
+	Song      *musicplaylist.Song
+	Duplicate *musicplaylist.Duplicate
+}
+
+// csvImportView is the data shown by the CSV import page, the step decides which part of the page is shown.
+type csvImportView struct {
+	Step       string         // "upload", "map" or "review"
+	Upload     *csvUpload     // The uploaded file
+	Fields     []string       // Song fields in display order
+	Mapping    map[string]int // Column index mapped onto every song field, -1 for none
+	Preview    []csvRow       // First rows of the file
+	Duplicates []csvRow       // Rows matching a stored song or an earlier row
+	New        int            // Number of rows matching neither
+	Error      string
+}
+
+// handleCSVImport handles the CSV import, which runs in steps posted back to the same page:
+// the file is uploaded, its columns are mapped onto song fields with a preview of the first rows,
+// the rows matching stored songs are reviewed one by one and finally the rows are imported.
+func (s *httpServer) handleCSVImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		renderCSVImport(w, &csvImportView{Step: "upload"})
+		return
+	}
+
+	// Read the file, uploaded in the first step and carried in a hidden field afterwards.
+	step := r.FormValue("step")
+	var upload *csvUpload
+	var err error
+	if step == "" {
+		upload, err = uploadCSV(r)
+	} else {
+		upload, err = decodeCSV(r.FormValue("data"))
+	}
+	if err != nil {
+		renderCSVImport(w, &csvImportView{Step: "upload", Error: err.Error()})
+		return
+	}
+
+	view := &csvImportView{Upload: upload, Fields: songFields, Mapping: guessMapping(upload.Header)}
+	if step != "" {
+		view.Mapping = formMapping(r, len(upload.Header))
+	}
+	for i := 0; i < len(upload.Rows) && i < csvPreviewRows; i++ {
+		view.Preview = append(view.Preview, upload.row(i, view.Mapping))
+	}
+	if step == "" || step == "map" {
+		view.Step = "map"
+		renderCSVImport(w, view)
+		return
+	}
+	if view.Mapping["title"] < 0 || view.Mapping["artist"] < 0 {
+		view.Step = "map"
+		view.Error = "Choose the columns holding the title and the artist"
+		renderCSVImport(w, view)
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	if step == "commit" {
+		s.commitCSVImport(ctx, w, r, upload, view.Mapping)
+		return
+	}
+
+	// Look for stored songs matching the rows of the file.
+	req := &musicplaylist.CheckDuplicatesRequest{}
+	for i := range upload.Rows {
+		req.Songs = append(req.Songs, upload.row(i, view.Mapping).Song)
+	}
+	report, err := s.songs.CheckDuplicates(ctx, req)
+	if err != nil {
+		log.Printf("Failed to check duplicates: %v\n", err)
+		renderError(w, "Failed to check duplicates", err)
+		return
+	}
+	for _, d := range report.Duplicates {
+		row := upload.row(int(d.Index), view.Mapping)
+		row.Duplicate = d
+		view.Duplicates = append(view.Duplicates, row)
+	}
+	view.New = len(upload.Rows) - len(view.Duplicates)
+	view.Step = "review"
+	renderCSVImport(w, view)
+}
+
+// commitCSVImport imports the rows of the file with the action chosen for every duplicate and shows the summary.
+func (s *httpServer) commitCSVImport(ctx context.Context, w http.ResponseWriter, r *http.Request, upload *csvUpload, mapping map[string]int) {
+	req := &musicplaylist.ImportRowsRequest{DryRun: r.FormValue("dry_run") != ""}
+	for i := range upload.Rows {
+		row := &musicplaylist.ImportRow{Song: upload.row(i, mapping).Song}
+		suffix := strconv.Itoa(i)
+		switch r.FormValue("action_" + suffix) {
+		case "skip":
+			row.Action = musicplaylist.ImportRow_SKIP
+		case "overwrite":
+			row.Action = musicplaylist.ImportRow_OVERWRITE
+			row.ExistingId = r.FormValue("existing_" + suffix)
+			row.ExistingVersion, _ = strconv.ParseInt(r.FormValue("version_"+suffix), 10, 64)
+		}
+		req.Rows = append(req.Rows, row)
+	}
+
+	summary, err := s.songs.ImportRows(ctx, req)
+	if err != nil {
+		log.Printf("Failed to import songs: %v\n", err)
+		renderError(w, "Failed to import songs", err)
+		return
+	}
+
+	var failures []importFailure
+	for _, f := range summary.Failures {
+		failures = append(failures, importFailure{Line: upload.Lines[f.Index], Reason: f.Reason})
+	}
+	renderImport(w, &importView{
+		Received: int(summary.Received),
+		Imported: int(summary.Imported),
+		Updated:  int(summary.Updated),
+		Skipped:  int(summary.Skipped),
+		DryRun:   summary.DryRun,
+		Unit:     "Line",
+		Failures: failures,
+	})
+}
+
+// renderCSVImport displays the current step of the CSV import.
+func renderCSVImport(w http.ResponseWriter, view *csvImportView) {
+	if view.Error != "" {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	tmpl := template.Must(template.New("csvimport").Funcs(templateFuncs).Parse(csvImportTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+	err := tmpl.Execute(w, view)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// uploadCSV reads the CSV file uploaded with the file field of the request.
+func uploadCSV(r *http.Request) (*csvUpload, error) {
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return nil, errors.New("choose a CSV file to import")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxCSVFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxCSVFileSize {
+		return nil, errors.New("the CSV file is too large")
+	}
+	return parseCSV(data)
+}
+
+// decodeCSV reads the CSV file carried in the hidden data field.
+func decodeCSV(encoded string) (*csvUpload, error) {
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New("the uploaded file was lost, upload it again")
+	}
+	return parseCSV(data)
+}
+
+// parseCSV reads the header and the data rows of a CSV file, skipping empty rows.
+func parseCSV(data []byte) (*csvUpload, error) {
+	cr := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\uFEFF"))))
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	upload := &csvUpload{Data: base64.StdEncoding.EncodeToString(data)}
+	header, err := cr.Read()
+	if err != nil {
+		return nil, errors.New("could not read the CSV header: " + err.Error())
+	}
+	for _, name := range header {
+		upload.Header = append(upload.Header, strings.TrimSpace(name))
+	}
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.New("could not read the CSV file: " + err.Error())
+		}
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+		line, _ := cr.FieldPos(0)
+		upload.Rows = append(upload.Rows, record)
+		upload.Lines = append(upload.Lines, line)
+	}
+	if len(upload.Rows) == 0 {
+		return nil, errors.New("the CSV file has no rows below the header")
+	}
+	return upload, nil
+}
+
+// row maps the data row i onto a song.
+func (u *csvUpload) row(i int, mapping map[string]int) csvRow {
+	value := func(field string) string {
+		if c := mapping[field]; c >= 0 && c < len(u.Rows[i]) {
+			return strings.TrimSpace(u.Rows[i][c])
+		}
+		return ""
+	}
+	return csvRow{
+		Index: i,
+		Line:  u.Lines[i],
+		Song: &musicplaylist.Song{
+			Title:    value("title"),
+			Artist:   value("artist"),
+			Album:    value("album"),
+			Duration: value("duration"),
+			Link:     value("link"),
+		},
+	}
+}
+
+// guessMapping maps every song field onto the first column whose header contains one of its synonyms.
+func guessMapping(header []string) map[string]int {
+	mapping := map[string]int{}
+	used := map[int]bool{}
+	for _, field := range songFields {
+		mapping[field] = -1
+		for _, synonym := range columnSynonyms[field] {
+			for c, name := range header {
+				if !used[c] && strings.Contains(strings.ToLower(name), synonym) {
+					mapping[field] = c
+					used[c] = true
+					break
+				}
+			}
+			if mapping[field] >= 0 {
+				break
+			}
+		}
+	}
+	return mapping
+}
+
+// formMapping reads the column chosen for every song field from the map_ fields of the request.
+func formMapping(r *http.Request, columns int) map[string]int {
+	mapping := map[string]int{}
+	for _, field := range songFields {
+		c, err := strconv.Atoi(r.FormValue("map_" + field))
+		if err != nil || c < 0 || c >= columns {
+			c = -1
+		}
+		mapping[field] = c
+	}
+	return mapping
+}
+
+// csvImportTemplate defines the HTML template for the steps of the CSV import.
+var csvImportTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>CSV Import - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>Import Tracks from CSV</h1>
+    {{with .Error}}<p class="error-message">{{.}}</p>{{end}}
+    {{if eq .Step "upload"}}
+    <p>Upload a spreadsheet saved as CSV, its first row must name the columns.</p>
+    <form action="/import/csv" method="post" enctype="multipart/form-data">
+        <input type="file" name="file" accept=".csv,.txt" required>
+        <input type="submit" value="Continue">
+    </form>
+    {{else}}
+    <form action="/import/csv" method="post">
+        <input type="hidden" name="data" value="{{.Upload.Data}}">
+        <h2>Columns</h2>
+        <div class="grid-form">
+            {{range $field := .Fields}}
+            <div class="form-group">
+                <label for="map_{{$field}}">{{$field}}:</label>
+                <select id="map_{{$field}}" name="map_{{$field}}">
+                    <option value="-1">(none)</option>
+                    {{range $c, $name := $.Upload.Header}}
+                    <option value="{{$c}}"{{if eq (index $.Mapping $field) $c}} selected{{end}}>{{$name}}</option>
+                    {{end}}
+                </select>
+            </div>
+            {{end}}
+        </div>
+        <h2>Preview</h2>
+        <table class="import-failures">
+            <tr><th>Line</th><th>Title</th><th>Artist</th><th>Album</th><th>Duration</th><th>Link</th></tr>
+            {{range .Preview}}
+            <tr><td>{{.Line}}</td><td>{{.Song.Title}}</td><td>{{.Song.Artist}}</td><td>{{.Song.Album}}</td><td>{{.Song.Duration}}</td><td>{{.Song.Link}}</td></tr>
+            {{end}}
+        </table>
+        <p>{{len .Upload.Rows}} rows in the file.</p>
+        {{if eq .Step "map"}}
+        <button type="submit" name="step" value="map">Update Preview</button>
+        <button type="submit" name="step" value="review">Check for Duplicates</button>
+        {{else}}
+        <h2>Duplicates</h2>
+        <p>{{.New}} new tracks will be added. {{len .Duplicates}} rows match tracks already in the catalog or earlier rows of the file:</p>
+        {{if .Duplicates}}
+        <table class="import-failures">
+            <tr><th>Line</th><th>In the file</th><th>Matches</th><th>Action</th></tr>
+            {{range .Duplicates}}
+            <tr>
+                <td>{{.Line}}</td>
+                <td>{{.Song.Title}} - {{.Song.Artist}}</td>
+                <td>{{.Duplicate.Existing.Title}} - {{.Duplicate.Existing.Artist}} ({{.Duplicate.Reason}})</td>
+                <td>
+                    {{if .Duplicate.Existing.Id}}
+                    <input type="hidden" name="existing_{{.Index}}" value="{{.Duplicate.Existing.Id}}">
+                    <input type="hidden" name="version_{{.Index}}" value="{{.Duplicate.Existing.Version}}">
+                    <select name="action_{{.Index}}">
+                        <option value="skip">Skip</option>
+                        <option value="overwrite">Overwrite</option>
+                        <option value="keep">Keep both</option>
+                    </select>
+                    {{else}}
+                    <select name="action_{{.Index}}">
+                        <option value="skip">Skip</option>
+                        <option value="keep">Keep both</option>
+                    </select>
+                    {{end}}
+                </td>
+            </tr>
+            {{end}}
+        </table>
+        {{end}}
+        <label><input type="checkbox" name="dry_run" value="1"> Only check the rows, do not import</label>
+        <button type="submit" name="step" value="map">Change Columns</button>
+        <button type="submit" name="step" value="commit">Import</button>
+        {{end}}
+    </form>
+    {{end}}
+    <a href="/import" class="refresh-btn">Other Formats</a>
+    <a href="/playlist" class="refresh-btn">All Tracks</a>
+</div>
+</body>
+</html>`
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// handleEvents streams the changes made to songs to the browser as server-sent events.
+// Each event is named after the kind of change and holds the changed song as JSON.
+// Browsers reconnecting with the Last-Event-ID header resume after the last event they received.
+func (s *httpServer) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	// Watch songs until the browser goes away, without deadline.
+	stream, err := s.songs.WatchSongs(r.Context(), &musicplaylist.WatchSongsRequest{
+		ResumeToken: r.Header.Get("Last-Event-ID"),
+	})
+	if err != nil {
+		log.Printf("Failed to watch songs: %v\n", err)
+		renderError(w, "Failed to watch songs", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if r.Context().Err() == nil {
+				log.Printf("Stopped watching songs: %v\n", err)
+			}
+			// The events after Last-Event-ID are gone, an empty id makes the browser reconnect without it
+			// and the reset event tells the page to reload everything it shows.
+			if code := status.Code(err); code == codes.InvalidArgument || code == codes.OutOfRange {
+				fmt.Fprint(w, "id:\nevent: reset\ndata: {}\n\n")
+				flusher.Flush()
+			}
+			return
+		}
+
+		data, err := protojson.Marshal(event.GetSong())
+		if err != nil {
+			log.Printf("Failed to encode song event: %v\n", err)
+			continue
+		}
+		fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n",
+			event.GetResumeToken(), strings.ToLower(event.GetType().String()), data)
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// exportColumns is the header row of CSV exports, in the order the fields of a song are written.
+var exportColumns = []string{"id", "title", "artist", "album", "duration", "duration_ms", "link", "version"}
+
+// handleExportCSV handles requests to download the songs as a CSV file.
+// The q, artist, album, min_duration_ms and max_duration_ms parameters filter the songs like the search form.
+func (s *httpServer) handleExportCSV(w http.ResponseWriter, r *http.Request) {
+	cw := csv.NewWriter(w)
+	header := false
+	ok := s.exportSongs(w, r, "text/csv; charset=utf-8", "songs.csv", func(song *musicplaylist.Song) error {
+		if !header {
+			header = true
+			if err := cw.Write(exportColumns); err != nil {
+				return err
+			}
+		}
+		err := cw.Write([]string{
+			song.Id, song.Title, song.Artist, song.Album, song.Duration,
+			strconv.FormatInt(song.DurationMs, 10), song.Link, strconv.FormatInt(song.Version, 10),
+		})
+		if err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	})
+	// Empty exports still get their header row, failed ones were answered with an error page.
+	if ok && !header {
+		cw.Write(exportColumns)
+		cw.Flush()
+	}
+}
+
+// handleExportJSONL handles requests to download the songs as a JSON Lines file, one song object per line.
+// It accepts the same filter parameters as handleExportCSV.
+func (s *httpServer) handleExportJSONL(w http.ResponseWriter, r *http.Request) {
+	s.exportSongs(w, r, "application/x-ndjson", "songs.jsonl", func(song *musicplaylist.Song) error {
+		data, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(song)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(data, '\n'))
+		return err
+	})
+}
+
+// exportSongs streams the songs matching the filter parameters of the request from ExportSongs to the response,
+// calling write for each song as soon as it arrives so the catalog is never held in memory.
+// Errors reported before the first song are shown as an error page, later ones end the download early.
+// It returns false when the error page was written instead of the download.
+func (s *httpServer) exportSongs(w http.ResponseWriter, r *http.Request, contentType, filename string, write func(*musicplaylist.Song) error) bool {
+	// Get filter parameters from URL.
+	req, err := exportRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return false
+	}
+
+	// Stop exporting when the download is cancelled, large exports may take longer than the deadline of a request.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	stream, err := s.songs.ExportSongs(ctx, req)
+	if err != nil {
+		renderError(w, "Failed to export songs", err)
+		return false
+	}
+
+	// Errors such as invalid filters arrive with the first message.
+	song, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		log.Printf("Failed to export songs: %v\n", err)
+		renderError(w, "Failed to export songs", err)
+		return false
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+	for err == nil {
+		if err = write(song); err != nil {
+			log.Printf("Failed to write exported song: %v\n", err)
+			return true
+		}
+		song, err = stream.Recv()
+	}
+	if err != io.EOF {
+		log.Printf("Export ended early: %v\n", err)
+	}
+	return true
+}
+
+// handleExportM3U handles requests to download the songs as an M3U8 playlist.
+// It accepts the same filter parameters as handleExportCSV.
+func (s *httpServer) handleExportM3U(w http.ResponseWriter, r *http.Request) {
+	s.exportFile(w, r, func(c musicplaylist.SongApiClient, ctx context.Context, req *musicplaylist.ExportSongsRequest) (playlistFileStream, error) {
+		return c.ExportM3U(ctx, req)
+	})
+}
+
+// handleExportXSPF handles requests to download the songs as an XSPF playlist.
+// It accepts the same filter parameters as handleExportCSV.
+func (s *httpServer) handleExportXSPF(w http.ResponseWriter, r *http.Request) {
+	s.exportFile(w, r, func(c musicplaylist.SongApiClient, ctx context.Context, req *musicplaylist.ExportSongsRequest) (playlistFileStream, error) {
+		return c.ExportXSPF(ctx, req)
+	})
+}
+
+// handlePlaylistExportM3U handles requests to download the playlist given by the id parameter as an M3U8 playlist.
+func (s *httpServer) handlePlaylistExportM3U(w http.ResponseWriter, r *http.Request) {
+	s.playlistExportFile(w, r, func(c musicplaylist.PlaylistApiClient, ctx context.Context, id *wrapperspb.StringValue) (playlistFileStream, error) {
+		return c.ExportM3U(ctx, id)
+	})
+}
+
+// handlePlaylistExportXSPF handles requests to download the playlist given by the id parameter as an XSPF playlist.
+func (s *httpServer) handlePlaylistExportXSPF(w http.ResponseWriter, r *http.Request) {
+	s.playlistExportFile(w, r, func(c musicplaylist.PlaylistApiClient, ctx context.Context, id *wrapperspb.StringValue) (playlistFileStream, error) {
+		return c.ExportXSPF(ctx, id)
+	})
+}
+
+// exportFile runs a SongApi call exporting the songs matching the filter parameters as a playlist file
+// and streams the file as a download.
+func (s *httpServer) exportFile(w http.ResponseWriter, r *http.Request, call func(musicplaylist.SongApiClient, context.Context, *musicplaylist.ExportSongsRequest) (playlistFileStream, error)) {
+	req, err := exportRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Stop exporting when the download is cancelled, large exports may take longer than the deadline of a request.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Export the songs.
+	stream, err := call(s.songs, ctx, req)
+	if err == nil {
+		err = servePlaylistFile(w, stream)
+	}
+	if err != nil {
+		log.Printf("Failed to export songs: %v\n", err)
+		renderError(w, "Failed to export songs", err)
+	}
+}
+
+// playlistExportFile runs a PlaylistApi call exporting the playlist given by the id parameter as a playlist file
+// and streams the file as a download.
+func (s *httpServer) playlistExportFile(w http.ResponseWriter, r *http.Request, call func(musicplaylist.PlaylistApiClient, context.Context, *wrapperspb.StringValue) (playlistFileStream, error)) {
+	// Stop exporting when the download is cancelled.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	// Export the playlist.
+	stream, err := call(s.playlists, ctx, &wrapperspb.StringValue{Value: r.FormValue("id")})
+	if err == nil {
+		err = servePlaylistFile(w, stream)
+	}
+	if err != nil {
+		log.Printf("Failed to export playlist: %v\n", err)
+		renderError(w, "Failed to export playlist", err)
+	}
+}
+
+// playlistFileStream is the client stream of a playlist file export, such as musicplaylist.SongApi_ExportM3UClient.
+type playlistFileStream interface {
+	Recv() (*musicplaylist.PlaylistFile, error)
+}
+
+// servePlaylistFile sends the chunks of a playlist file as a download while they arrive.
+// It returns the error of the export when it fails before the first chunk, so it can be shown as an error page,
+// later errors end the download early.
+func servePlaylistFile(w http.ResponseWriter, stream playlistFileStream) error {
+	file, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+	for err == nil {
+		if _, err = w.Write(file.Content); err != nil {
+			log.Printf("Failed to write exported file: %v\n", err)
+			return nil
+		}
+		file, err = stream.Recv()
+	}
+	if err != io.EOF {
+		log.Printf("Export ended early: %v\n", err)
+	}
+	return nil
+}
+
+// exportRequest reads the q, artist, album, min_duration_ms and max_duration_ms filter parameters of an export.
+func exportRequest(r *http.Request) (*musicplaylist.ExportSongsRequest, error) {
+	query := r.URL.Query()
+	req := &musicplaylist.ExportSongsRequest{
+		Query:  query.Get("q"),
+		Artist: query.Get("artist"),
+		Album:  query.Get("album"),
+	}
+	var err error
+	if v := query.Get("min_duration_ms"); v != "" {
+		if req.MinDurationMs, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, errors.New("min_duration_ms must be a number")
+		}
+	}
+	if v := query.Get("max_duration_ms"); v != "" {
+		if req.MaxDurationMs, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, errors.New("max_duration_ms must be a number")
+		}
+	}
+	return req, nil
+}
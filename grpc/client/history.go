@@ -0,0 +1,170 @@
+package main
+
+import (
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// handleHistory handles requests to show the audit trail of a song.
+// Each change lists the fields it changed and can be reverted to while the song is not in the trash.
+func (s *httpServer) handleHistory(w http.ResponseWriter, r *http.Request) {
+	// Get song ID from URL parameter.
+	id := r.URL.Query().Get("id")
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Fetch history of the song from server.
+	history, err := s.songs.ListSongHistory(ctx, &wrapperspb.StringValue{Value: id})
+	if err != nil {
+		log.Printf("Failed to fetch song history: %v\n", err)
+		renderError(w, "Failed to fetch song history", err)
+		return
+	}
+
+	// Fetch the current song, which is missing once it was deleted.
+	song, err := s.songs.GetSong(ctx, &wrapperspb.StringValue{Value: id})
+	if err != nil && status.Code(err) != codes.NotFound {
+		renderError(w, "Failed to fetch song", err)
+		return
+	}
+
+	// Prepare history data for display in HTML page.
+	type Change struct {
+		Field  string
+		Before string
+		After  string
+	}
+	type Entry struct {
+		Audit   *musicplaylist.SongAudit
+		Changes []Change
+	}
+	type ViewData struct {
+		ID      string
+		Song    *musicplaylist.Song
+		Entries []Entry
+	}
+	data := ViewData{ID: id, Song: song}
+	for _, audit := range history.Entries {
+		entry := Entry{Audit: audit}
+		before, after := audit.Before, audit.After
+		if before == nil {
+			before = &musicplaylist.Song{}
+		}
+		if after == nil {
+			after = &musicplaylist.Song{}
+		}
+		for _, field := range []struct{ name, before, after string }{
+			{"Title", before.Title, after.Title},
+			{"Artist", before.Artist, after.Artist},
+			{"Album", before.Album, after.Album},
+			{"Duration", before.Duration, after.Duration},
+			{"Link", before.Link, after.Link},
+		} {
+			if field.before != field.after {
+				entry.Changes = append(entry.Changes, Change{Field: field.name, Before: field.before, After: field.after})
+			}
+		}
+		data.Entries = append(data.Entries, entry)
+	}
+
+	// Create HTML template.
+	tmpl := template.Must(template.New("history").Parse(historyTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+
+	// Display HTML template with prepared history data.
+	err = tmpl.Execute(w, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleHistoryRevert handles requests to revert a song to the state it had after a change.
+func (s *httpServer) handleHistoryRevert(w http.ResponseWriter, r *http.Request) {
+	if !requirePost(w, r) {
+		return
+	}
+
+	// Get song ID, audit entry ID and song version from the form.
+	id := r.FormValue("id")
+	auditID := r.FormValue("audit")
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 64)
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Revert song.
+	_, err := s.songs.RevertSong(ctx, &musicplaylist.RevertSongRequest{
+		SongId:  id,
+		AuditId: auditID,
+		Version: version,
+	})
+	if err != nil {
+		// Someone else changed the song since the history was loaded.
+		if status.Code(err) == codes.Aborted {
+			renderConflict(w, id, true)
+			return
+		}
+		renderError(w, "Failed to revert song", err)
+		return
+	}
+
+	// Redirect to history page.
+	http.Redirect(w, r, "/history?"+url.Values{"id": {id}}.Encode(), http.StatusSeeOther)
+}
+
+// historyTemplate defines the HTML template for the audit trail of a song.
+var historyTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>History - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>History</h1>
+    {{with .Song}}
+    <h2>{{.Title}} - {{.Artist}}</h2>
+    {{else}}
+    <p>This song was deleted, restore it from the <a href="/trash">trash</a> to revert it.</p>
+    {{end}}
+    <a href="/playlist" class="refresh-btn">All Tracks</a>
+    <ul>
+        {{range .Entries}}
+        <li>
+            <span>{{.Audit.Time.AsTime.Format "2006-01-02 15:04:05"}} - {{.Audit.Rpc}} by {{.Audit.Actor}}</span>
+            {{if and $.Song .Audit.After}}
+            <div class="action-buttons">
+                <form action="/history/revert" method="post" class="action-form">
+                    <input type="hidden" name="id" value="{{$.ID}}">
+                    <input type="hidden" name="audit" value="{{.Audit.Id}}">
+                    <input type="hidden" name="version" value="{{$.Song.Version}}">
+                    <button type="submit" class="link-btn">Revert to this</button>
+                </form>
+            </div>
+            {{end}}
+            <ul>
+                {{range .Changes}}
+                <li>{{.Field}}: {{if .Before}}{{.Before}}{{else}}(empty){{end}} &rarr; {{if .After}}{{.After}}{{else}}(empty){{end}}</li>
+                {{end}}
+            </ul>
+        </li>
+        {{else}}
+        <li>No changes recorded for this song</li>
+        {{end}}
+    </ul>
+</div>
+</body>
+</html>`
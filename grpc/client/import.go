@@ -0,0 +1,319 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// importFailure is a line of an uploaded file that was not imported.
+type importFailure struct {
+	Line   int
+	Reason string
+}
+
+// rowError reports a line of an uploaded file that could not be read as a song, the rest of the file is still read.
+type rowError struct {
+	err error
+}
+
+func (e *rowError) Error() string { return e.err.Error() }
+
+// songReader returns the next song of an uploaded file along with the line it starts on.
+// It returns io.EOF after the last song and a *rowError for lines that are not a song.
+type songReader func() (*musicplaylist.Song, int, error)
+
+// handleImport handles requests to import songs from an uploaded CSV, JSON Lines, M3U or XSPF file.
+// GET shows the upload form, POST streams the songs of the file to ImportSongs and shows the summary.
+func (s *httpServer) handleImport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		renderImport(w, nil)
+		return
+	}
+
+	// Retrieve the uploaded file from the HTML form.
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Choose a file to import", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	dryRun := r.FormValue("dry_run") != ""
+
+	// Playlists are read by the server.
+	switch name := strings.ToLower(header.Filename); {
+	case strings.HasSuffix(name, ".m3u") || strings.HasSuffix(name, ".m3u8"):
+		s.importPlaylistFile(w, r, file, dryRun, musicplaylist.SongApiClient.ImportM3U)
+		return
+	case strings.HasSuffix(name, ".xspf"):
+		s.importPlaylistFile(w, r, file, dryRun, musicplaylist.SongApiClient.ImportXSPF)
+		return
+	}
+
+	next, err := newSongReader(header.Filename, file)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Stream the songs of the file while reading it, large files may take longer than the deadline of a request.
+	ctx := r.Context()
+	if dryRun {
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-dry-run", "true")
+	}
+	stream, err := s.songs.ImportSongs(ctx)
+	if err != nil {
+		renderError(w, "Failed to import songs", err)
+		return
+	}
+
+	var lines []int // Line of every streamed song, indexed like the songs of the stream
+	var failures []importFailure
+	for {
+		song, line, err := next()
+		if err == io.EOF {
+			break
+		}
+		var rowErr *rowError
+		if errors.As(err, &rowErr) {
+			failures = append(failures, importFailure{Line: line, Reason: rowErr.Error()})
+			continue
+		}
+		if err != nil {
+			stream.CloseSend()
+			http.Error(w, "Could not read the file: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		// The server ended the stream, CloseAndRecv returns why.
+		if err := stream.Send(song); err != nil {
+			break
+		}
+		lines = append(lines, line)
+	}
+	summary, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Printf("Failed to import songs: %v\n", err)
+		renderError(w, "Failed to import songs", err)
+		return
+	}
+
+	// Lines that could not be read count as received songs too.
+	received := int(summary.Received) + len(failures)
+
+	// Report the failures of the server at the line of the file they come from.
+	for _, f := range summary.Failures {
+		failures = append(failures, importFailure{Line: lines[f.Index], Reason: f.Reason})
+	}
+	sort.Slice(failures, func(i, j int) bool { return failures[i].Line < failures[j].Line })
+	renderImport(w, &importView{
+		Received: received,
+		Imported: int(summary.Imported),
+		DryRun:   summary.DryRun,
+		Unit:     "Line",
+		Failures: failures,
+	})
+}
+
+// importPlaylistFile sends an uploaded playlist file to the SongApi call importing its format and shows the summary.
+// Failures are reported by the number of the entry in the playlist.
+func (s *httpServer) importPlaylistFile(w http.ResponseWriter, r *http.Request, file io.Reader, dryRun bool, call func(musicplaylist.SongApiClient, context.Context, *musicplaylist.ImportFileRequest, ...grpc.CallOption) (*musicplaylist.ImportSummary, error)) {
+	content, err := io.ReadAll(io.LimitReader(file, maxPlaylistFileSize+1))
+	if err != nil {
+		http.Error(w, "Could not read the file: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(content) > maxPlaylistFileSize {
+		http.Error(w, "The playlist file is too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Import the playlist.
+	summary, err := call(s.songs, ctx, &musicplaylist.ImportFileRequest{
+		Content: content,
+		DryRun:  dryRun,
+	})
+	if err != nil {
+		log.Printf("Failed to import playlist: %v\n", err)
+		renderError(w, "Failed to import playlist", err)
+		return
+	}
+
+	var failures []importFailure
+	for _, f := range summary.Failures {
+		failures = append(failures, importFailure{Line: int(f.Index) + 1, Reason: f.Reason})
+	}
+	renderImport(w, &importView{
+		Received: int(summary.Received),
+		Imported: int(summary.Imported),
+		DryRun:   summary.DryRun,
+		Unit:     "Entry",
+		Failures: failures,
+	})
+}
+
+// maxPlaylistFileSize is the largest playlist file, in bytes, sent to the server in a single message.
+const maxPlaylistFileSize = 3 << 20
+
+// importView is the outcome of an import shown below the upload form.
+type importView struct {
+	Received int
+	Imported int
+	Updated  int
+	Skipped  int
+	DryRun   bool
+	Unit     string // What the numbers of the failures count, lines or playlist entries
+	Failures []importFailure
+}
+
+// renderImport displays the upload form along with the outcome of the last import, if any.
+func renderImport(w http.ResponseWriter, result *importView) {
+	tmpl := template.Must(template.New("import").Parse(importTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+	err := tmpl.Execute(w, result)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// newSongReader returns a songReader for an uploaded file.
+// Files named *.csv must start with a header naming the title, artist, album, duration, duration_ms and link columns,
+// any other file is read as JSON Lines with one song object per line.
+func newSongReader(filename string, file io.Reader) (songReader, error) {
+	if strings.HasSuffix(strings.ToLower(filename), ".csv") {
+		return newCSVSongReader(file)
+	}
+	return newJSONSongReader(file), nil
+}
+
+// newCSVSongReader returns a songReader for a CSV file, columns are matched to song fields by their header.
+func newCSVSongReader(file io.Reader) (songReader, error) {
+	cr := csv.NewReader(file)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("could not read the CSV header: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, errors.New("the CSV header has no title column")
+	}
+
+	return func() (*musicplaylist.Song, int, error) {
+		record, err := cr.Read()
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				return nil, parseErr.StartLine, &rowError{err: parseErr.Err}
+			}
+			return nil, 0, err
+		}
+		line, _ := cr.FieldPos(0)
+
+		field := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+		song := &musicplaylist.Song{
+			Title:    field("title"),
+			Artist:   field("artist"),
+			Album:    field("album"),
+			Duration: field("duration"),
+			Link:     field("link"),
+		}
+		if ms := field("duration_ms"); ms != "" {
+			song.DurationMs, err = strconv.ParseInt(ms, 10, 64)
+			if err != nil {
+				return nil, line, &rowError{err: fmt.Errorf("duration_ms %q is not a number", ms)}
+			}
+		}
+		return song, line, nil
+	}, nil
+}
+
+// newJSONSongReader returns a songReader for a JSON Lines file, blank lines are skipped.
+func newJSONSongReader(file io.Reader) songReader {
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	line := 0
+
+	return func() (*musicplaylist.Song, int, error) {
+		for scanner.Scan() {
+			line++
+			text := strings.TrimSpace(scanner.Text())
+			if text == "" {
+				continue
+			}
+			song := &musicplaylist.Song{}
+			err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal([]byte(text), song)
+			if err != nil {
+				return nil, line, &rowError{err: errors.New("not a valid song object")}
+			}
+			return song, line, nil
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, line, err
+		}
+		return nil, line, io.EOF
+	}
+}
+
+// importTemplate defines the HTML template for the import form and its outcome.
+var importTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Import - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>Import Tracks</h1>
+    <p>Upload a CSV file with a header row (title, artist, album, duration, link), a JSON Lines file with one track per line or an M3U/M3U8 or XSPF playlist.</p>
+    <form action="/import" method="post" enctype="multipart/form-data">
+        <input type="file" name="file" accept=".csv,.jsonl,.json,.txt,.m3u,.m3u8,.xspf" required>
+        <label><input type="checkbox" name="dry_run" value="1"> Only check the file, do not import</label>
+        <input type="submit" value="Import">
+    </form>
+    <p>To map spreadsheet columns and review duplicates first, use the <a href="/import/csv">CSV import</a>.</p>
+    {{with .}}
+    <hr>
+    <h2>{{if .DryRun}}Check finished{{else}}Import finished{{end}}</h2>
+    <p>{{.Imported}} of {{.Received}} tracks {{if .DryRun}}can be imported{{else}}imported{{end}}{{if .Updated}}, {{.Updated}} {{if .DryRun}}can be overwritten{{else}}overwritten{{end}}{{end}}{{if .Skipped}}, {{.Skipped}} skipped{{end}}, {{len .Failures}} failed.</p>
+    {{if .Failures}}
+    <table class="import-failures">
+        <tr><th>{{.Unit}}</th><th>Problem</th></tr>
+        {{range .Failures}}
+        <tr><td>{{.Line}}</td><td>{{.Reason}}</td></tr>
+        {{end}}
+    </table>
+    {{end}}
+    {{end}}
+    <a href="/playlist" class="refresh-btn">All Tracks</a>
+</div>
+</body>
+</html>`
@@ -1,28 +1,47 @@
 package main
 
 import (
-	"context"
 	"html/template"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
 	"github.com/spf13/viper"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// pageSize is the number of songs shown on each page of the playlist.
+const pageSize = 10
+
 // httpServer represents an HTTP server.
 type httpServer struct {
-	addr string
+	addr      string
+	songs     musicplaylist.SongApiClient     // Client of the song service shared by all requests
+	playlists musicplaylist.PlaylistApiClient // Client of the playlist service shared by all requests
+	timeout   time.Duration                   // Deadline of the gRPC calls made for a request
+	auth      *clientAuth                     // Sessions of the users and the tokens forwarded for them
 }
 
 // NewHttpServer creates a new instance of httpServer.
-func NewHttpServer(addr string) *httpServer {
-	return &httpServer{addr: addr}
+// It takes the address to listen on, the connection to the gRPC server shared by all requests,
+// the deadline of the gRPC calls made for a request and the authentication of the users as input.
+func NewHttpServer(addr string, conn grpc.ClientConnInterface, timeout time.Duration, auth *clientAuth) *httpServer {
+	return &httpServer{
+		addr:      addr,
+		songs:     musicplaylist.NewSongApiClient(conn),
+		playlists: musicplaylist.NewPlaylistApiClient(conn),
+		timeout:   timeout,
+		auth:      auth,
+	}
 }
 
 // init initializes the configuration.
@@ -41,6 +60,10 @@ func init() {
 	if err != nil {
 		panic("Fatal error config file: " + err.Error())
 	}
+
+	// Secrets are read from the environment rather than the config files.
+	viper.BindEnv("app.auth.jwt.hmac_secret", "MUSICPLAYLIST_JWT_SECRET")
+	viper.BindEnv("app.auth.session.secret", "MUSICPLAYLIST_SESSION_SECRET")
 }
 
 // Run starts the HTTP server.
@@ -50,13 +73,38 @@ func (s *httpServer) Run() error {
 	http.HandleFunc("/update", s.handleUpdate)
 	http.HandleFunc("/delete", s.handleDelete)
 	http.HandleFunc("/playlist", s.handleList)
+	http.HandleFunc("/playlists", s.handlePlaylists)
+	http.HandleFunc("/playlists/create", s.handlePlaylistCreate)
+	http.HandleFunc("/playlists/rename", s.handlePlaylistRename)
+	http.HandleFunc("/playlists/delete", s.handlePlaylistDelete)
+	http.HandleFunc("/playlists/add", s.handlePlaylistAdd)
+	http.HandleFunc("/playlists/remove", s.handlePlaylistRemove)
+	http.HandleFunc("/playlists/move", s.handlePlaylistMove)
+	http.HandleFunc("/history", s.handleHistory)
+	http.HandleFunc("/history/revert", s.handleHistoryRevert)
+	http.HandleFunc("/trash", s.handleTrash)
+	http.HandleFunc("/trash/restore", s.handleTrashRestore)
+	http.HandleFunc("/trash/purge", s.handleTrashPurge)
+	http.HandleFunc("/events", s.handleEvents)
+	http.HandleFunc("/import", s.handleImport)
+	http.HandleFunc("/import/csv", s.handleCSVImport)
+	http.HandleFunc("/export.csv", s.handleExportCSV)
+	http.HandleFunc("/export.jsonl", s.handleExportJSONL)
+	http.HandleFunc("/export.m3u8", s.handleExportM3U)
+	http.HandleFunc("/export.xspf", s.handleExportXSPF)
+	http.HandleFunc("/playlists/export.m3u8", s.handlePlaylistExportM3U)
+	http.HandleFunc("/playlists/export.xspf", s.handlePlaylistExportXSPF)
+	http.HandleFunc("/login", s.handleLogin)
+	http.HandleFunc("/logout", s.handleLogout)
+	s.registerAPI(http.DefaultServeMux)
 	log.Printf("Starting HTTP server on localhost%s/playlist\n", s.addr)
-	return http.ListenAndServe(s.addr, nil)
+	return http.ListenAndServe(s.addr, s.authenticate(http.DefaultServeMux))
 }
 
 // handleIndex handles requests to the index page.
 func (s *httpServer) handleIndex(w http.ResponseWriter, r *http.Request) {
-	tmpl := template.Must(template.New("index").Parse(songsTemplate))
+	tmpl := template.Must(template.New("index").Funcs(templateFuncs).Parse(songsTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
 	if err := tmpl.Execute(w, nil); err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -72,28 +120,26 @@ func (s *httpServer) handleCreate(w http.ResponseWriter, r *http.Request) {
 	duration := r.FormValue("duration")
 	link := r.FormValue("link")
 
-	// Initialize gRPC connection.
-	port := ":" + viper.GetString("app.grpc.port")
-	client, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		http.Error(w, "Could not connect to gRPC server", http.StatusInternalServerError)
-		return
-	}
-	defer client.Close()
-
-	// Create song client.
-	songClient := musicplaylist.NewSongApiClient(client)
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
 
 	// Create new song.
-	_, err = songClient.CreateSong(context.Background(), &musicplaylist.Song{
+	form := &musicplaylist.Song{
 		Title:    title,
 		Artist:   artist,
 		Album:    album,
 		Duration: duration,
 		Link:     link,
-	})
+	}
+	_, err := s.songs.CreateSong(ctx, form)
 	if err != nil {
-		http.Error(w, "Failed to create song", http.StatusInternalServerError)
+		// Show the form again with the problems next to the inputs.
+		if errs := fieldErrors(err); errs != nil {
+			s.renderList(w, r, form, errs)
+			return
+		}
+		renderError(w, "Failed to create song", err)
 		return
 	}
 
@@ -102,7 +148,13 @@ func (s *httpServer) handleCreate(w http.ResponseWriter, r *http.Request) {
 }
 
 // handleUpdate handles requests to update an existing song.
+// A GET request renders the update form for the song, a POST request submits it.
 func (s *httpServer) handleUpdate(w http.ResponseWriter, r *http.Request) {
+	if r.Method == http.MethodGet {
+		s.handleUpdateForm(w, r)
+		return
+	}
+
 	// Retrieve data from HTML form.
 	id := r.FormValue("id")
 	title := r.FormValue("title")
@@ -110,30 +162,35 @@ func (s *httpServer) handleUpdate(w http.ResponseWriter, r *http.Request) {
 	album := r.FormValue("album")
 	duration := r.FormValue("duration")
 	link := r.FormValue("link")
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 64)
 
-	// Initialize gRPC connection.
-	port := ":" + viper.GetString("app.grpc.port")
-	client, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		http.Error(w, "Could not connect to gRPC server", http.StatusInternalServerError)
-		return
-	}
-	defer client.Close()
-
-	// Create song client.
-	songClient := musicplaylist.NewSongApiClient(client)
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
 
 	// Update song.
-	_, err = songClient.UpdateSong(context.Background(), &musicplaylist.Song{
+	form := &musicplaylist.Song{
 		Id:       id,
 		Title:    title,
 		Artist:   artist,
 		Album:    album,
 		Duration: duration,
 		Link:     link,
-	})
+		Version:  version,
+	}
+	_, err := s.songs.UpdateSong(ctx, &musicplaylist.UpdateSongRequest{Song: form})
 	if err != nil {
-		http.Error(w, "Failed to update song", http.StatusInternalServerError)
+		// Show the form again with the problems next to the inputs.
+		if errs := fieldErrors(err); errs != nil {
+			renderUpdateForm(w, form, errs)
+			return
+		}
+		// Someone else changed the song since the form was loaded.
+		if status.Code(err) == codes.Aborted {
+			renderConflict(w, id, true)
+			return
+		}
+		renderError(w, "Failed to update song", err)
 		return
 	}
 
@@ -141,27 +198,69 @@ func (s *httpServer) handleUpdate(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, "/playlist", http.StatusSeeOther)
 }
 
-// handleDelete handles requests to delete an existing song.
-func (s *httpServer) handleDelete(w http.ResponseWriter, r *http.Request) {
+// handleUpdateForm renders the update form for a single song loaded through GetSong.
+func (s *httpServer) handleUpdateForm(w http.ResponseWriter, r *http.Request) {
 	// Get song ID from URL parameter.
 	id := r.URL.Query().Get("id")
 
-	// Initialize gRPC connection.
-	port := ":" + viper.GetString("app.grpc.port")
-	client, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Fetch the song from server.
+	song, err := s.songs.GetSong(ctx, &wrapperspb.StringValue{Value: id})
+	if err != nil {
+		renderError(w, "Failed to fetch song", err)
+		return
+	}
+
+	// Display update form with the song data.
+	renderUpdateForm(w, song, nil)
+}
+
+// renderUpdateForm displays the update form for a song.
+// When errs is set the form is shown with status 400 and the messages next to the inputs.
+func renderUpdateForm(w http.ResponseWriter, song *musicplaylist.Song, errs map[string]string) {
+	type ViewData struct {
+		Song   *musicplaylist.Song
+		Errors map[string]string
+	}
+
+	tmpl := template.Must(template.New("update").Parse(updateTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+	if errs != nil {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	err := tmpl.Execute(w, ViewData{Song: song, Errors: errs})
 	if err != nil {
-		http.Error(w, "Could not connect to gRPC server", http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleDelete handles requests to delete an existing song.
+func (s *httpServer) handleDelete(w http.ResponseWriter, r *http.Request) {
+	if !requirePost(w, r) {
 		return
 	}
-	defer client.Close()
 
-	// Create song client.
-	songClient := musicplaylist.NewSongApiClient(client)
+	// Get song ID and version from the form.
+	id := r.FormValue("id")
+	version, _ := strconv.ParseInt(r.FormValue("version"), 10, 64)
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
 
 	// Delete song.
-	_, err = songClient.DeleteSong(context.Background(), &wrapperspb.StringValue{Value: id})
+	_, err := s.songs.DeleteSong(ctx, &musicplaylist.DeleteSongRequest{Id: id, Version: version})
 	if err != nil {
-		http.Error(w, "Failed to delete song", http.StatusInternalServerError)
+		// Someone else changed the song since the list was loaded.
+		if status.Code(err) == codes.Aborted {
+			renderConflict(w, id, false)
+			return
+		}
+		renderError(w, "Failed to delete song", err)
 		return
 	}
 
@@ -169,40 +268,112 @@ func (s *httpServer) handleDelete(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, "/playlist", http.StatusSeeOther)
 }
 
-// handleList handles requests to list all songs.
+// handleList handles requests to list a page of songs.
+// The page_token parameter selects the page, the prev parameter carries the tokens of the pages before it.
 func (s *httpServer) handleList(w http.ResponseWriter, r *http.Request) {
-	// Initialize gRPC connection.
-	port := ":" + viper.GetString("app.grpc.port")
-	client, err := grpc.Dial(port, grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		http.Error(w, "Could not connect to gRPC server", http.StatusInternalServerError)
-		return
-	}
-	defer client.Close()
-
-	// Create song client.
-	songClient := musicplaylist.NewSongApiClient(client)
+	s.renderList(w, r, nil, nil)
+}
 
-	// Fetch list of songs from server.
-	songs, err := songClient.ListSongs(context.Background(), &emptypb.Empty{})
+// renderList displays a page of songs below the create form.
+// When errs is set the page is shown with status 400, the create form is filled from form
+// and the messages are shown next to the inputs.
+func (s *httpServer) renderList(w http.ResponseWriter, r *http.Request, form *musicplaylist.Song, errs map[string]string) {
+	// Get paging parameters from URL.
+	query := r.URL.Query()
+	pageToken := query.Get("page_token")
+	var history []string
+	if query.Has("prev") {
+		history = strings.Split(query.Get("prev"), ",")
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Search songs when search parameters are given, otherwise fetch a page of songs from server.
+	search := &musicplaylist.SearchSongsRequest{
+		Query:  query.Get("q"),
+		Artist: query.Get("artist"),
+		Album:  query.Get("album"),
+	}
+	searching := search.Query != "" || search.Artist != "" || search.Album != ""
+	var songs *musicplaylist.SongList
+	var err error
+	if searching {
+		songs, err = s.songs.SearchSongs(ctx, search)
+	} else {
+		songs, err = s.songs.ListSongs(ctx, &musicplaylist.ListSongsRequest{
+			PageSize:  pageSize,
+			PageToken: pageToken,
+		})
+	}
 	if err != nil {
-		http.Error(w, "Failed to fetch songs: "+err.Error(), http.StatusInternalServerError)
 		log.Printf("Failed to fetch songs: %v\n", err)
+		renderError(w, "Failed to fetch songs", err)
 		return
 	}
 
 	// Prepare song data for display in HTML page.
 	type ViewData struct {
-		Songs []*musicplaylist.Song
+		Songs     []*musicplaylist.Song
+		Total     int64
+		PrevURL   string
+		NextURL   string
+		Search    *musicplaylist.SearchSongsRequest
+		Form      *musicplaylist.Song
+		Errors    map[string]string
+		CSVURL    string
+		JSONLURL  string
+		M3UURL    string
+		XSPFURL   string
+		CanUpdate bool // Whether the policy lets the user update songs, otherwise the action is hidden
+		CanDelete bool // Whether the policy lets the user delete songs, otherwise the action is hidden
 	}
 	data := ViewData{
-		Songs: songs.List,
+		Songs:     songs.List,
+		Total:     songs.TotalSize,
+		Search:    search,
+		Form:      form,
+		Errors:    errs,
+		CanUpdate: s.auth.allows(r, "SongApi/UpdateSong"),
+		CanDelete: s.auth.allows(r, "SongApi/DeleteSong"),
+	}
+	// Export the songs matching the search, or every song.
+	export := url.Values{}
+	for _, name := range []string{"q", "artist", "album"} {
+		if v := query.Get(name); v != "" {
+			export.Set(name, v)
+		}
+	}
+	data.CSVURL = "/export.csv?" + export.Encode()
+	data.JSONLURL = "/export.jsonl?" + export.Encode()
+	data.M3UURL = "/export.m3u8?" + export.Encode()
+	data.XSPFURL = "/export.xspf?" + export.Encode()
+	if !searching && (pageToken != "" || len(history) > 0) {
+		prev := url.Values{}
+		if len(history) > 0 {
+			prev.Set("page_token", history[len(history)-1])
+			if len(history) > 1 {
+				prev.Set("prev", strings.Join(history[:len(history)-1], ","))
+			}
+		}
+		data.PrevURL = "/playlist?" + prev.Encode()
+	}
+	if !searching && songs.NextPageToken != "" {
+		next := url.Values{}
+		next.Set("page_token", songs.NextPageToken)
+		next.Set("prev", strings.Join(append(history, pageToken), ","))
+		data.NextURL = "/playlist?" + next.Encode()
 	}
 
 	// Create HTML template.
-	tmpl := template.Must(template.New("index").Parse(songsTemplate))
+	tmpl := template.Must(template.New("index").Funcs(templateFuncs).Parse(songsTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
 
 	// Display HTML template with prepared song data.
+	if errs != nil {
+		w.WriteHeader(http.StatusBadRequest)
+	}
 	err = tmpl.Execute(w, data)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -210,9 +381,156 @@ func (s *httpServer) handleList(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// httpStatus maps the gRPC status code of an error to the matching HTTP status code.
+func httpStatus(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument, codes.OutOfRange, codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// renderConflict displays the page shown when a song was changed by someone else
+// between loading it and submitting an update or deletion.
+// The page links to the update form so the latest version of the song can be reviewed.
+func renderConflict(w http.ResponseWriter, id string, updating bool) {
+	// Prepare conflict data for display in HTML page.
+	type ViewData struct {
+		ID       string
+		Updating bool
+	}
+
+	// Create HTML template.
+	tmpl := template.Must(template.New("conflict").Parse(conflictTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+
+	// Display HTML template with the conflict.
+	w.WriteHeader(http.StatusConflict)
+	if err := tmpl.Execute(w, ViewData{ID: id, Updating: updating}); err != nil {
+		log.Printf("Failed to render conflict page: %v\n", err)
+	}
+}
+
+// requirePost answers 405 Method Not Allowed to requests other than POST and returns whether the request may go on.
+// Handlers changing data only accept forms posted from the pages, so links, prefetchers and
+// cross-site GET requests carrying the session cookie cannot trigger the changes.
+func requirePost(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method == http.MethodPost {
+		return true
+	}
+	w.Header().Set("Allow", http.MethodPost)
+	http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	return false
+}
+
+// renderError displays the error page for a failed gRPC call.
+// The HTTP status code of the page is derived from the gRPC status code of the error.
+func renderError(w http.ResponseWriter, action string, err error) {
+	code := httpStatus(err)
+
+	// Prepare error data for display in HTML page.
+	type ViewData struct {
+		Code    int
+		Status  string
+		Action  string
+		Message string
+	}
+	data := ViewData{
+		Code:    code,
+		Status:  http.StatusText(code),
+		Action:  action,
+		Message: status.Convert(err).Message(),
+	}
+
+	// Create HTML template.
+	tmpl := template.Must(template.New("error").Parse(errorTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+
+	// Display HTML template with the error.
+	w.WriteHeader(code)
+	if err := tmpl.Execute(w, data); err != nil {
+		log.Printf("Failed to render error page: %v\n", err)
+	}
+}
+
+// fieldErrors returns the field violations carried in the details of a gRPC error, keyed by field name.
+// It returns nil if the error has none.
+func fieldErrors(err error) map[string]string {
+	var errs map[string]string
+	for _, detail := range status.Convert(err).Details() {
+		badRequest, ok := detail.(*errdetails.BadRequest)
+		if !ok {
+			continue
+		}
+		for _, violation := range badRequest.GetFieldViolations() {
+			if errs == nil {
+				errs = make(map[string]string)
+			}
+			errs[violation.GetField()] = violation.GetDescription()
+		}
+	}
+	return errs
+}
+
+// templateFuncs holds the functions available to the HTML templates.
+var templateFuncs = template.FuncMap{
+	"add":      func(a, b int) int { return a + b },
+	"embedURL": embedURL,
+}
+
+// embedURL returns the URL of the player embedding the song link.
+// Numeric links are SoundCloud track IDs, YouTube URLs use the YouTube player and other URLs the SoundCloud player.
+func embedURL(link string) string {
+	const soundcloudPlayer = "https://w.soundcloud.com/player/?color=%23ff5500&auto_play=false&hide_related=true" +
+		"&show_comments=false&show_user=false&show_reposts=false&show_teaser=true&visual=true&url="
+
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return soundcloudPlayer + url.QueryEscape("https://api.soundcloud.com/tracks/"+link)
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch {
+	case host == "youtu.be":
+		return "https://www.youtube.com/embed/" + url.PathEscape(strings.Trim(u.Path, "/"))
+	case host == "youtube.com" || strings.HasSuffix(host, ".youtube.com"):
+		videoID := u.Query().Get("v")
+		if videoID == "" {
+			// Paths such as /embed/ID and /shorts/ID end with the video ID.
+			videoID = u.Path[strings.LastIndex(u.Path, "/")+1:]
+		}
+		return "https://www.youtube.com/embed/" + url.PathEscape(videoID)
+	}
+	return soundcloudPlayer + url.QueryEscape(link)
+}
+
 //run the local server
 func main() {
-	httpServer := NewHttpServer(":9999")
+	conn, err := dialGRPC()
+	if err != nil {
+		log.Fatalf("could not create gRPC client: %v", err)
+	}
+	defer conn.Close()
+
+	auth, err := newClientAuth()
+	if err != nil {
+		log.Fatalf("could not configure authentication: %v", err)
+	}
+
+	httpServer := NewHttpServer(":9999", conn, viper.GetDuration("app.grpc.timeout"), auth)
 	httpServer.Run()
 }
 
@@ -222,6 +540,122 @@ var songsTemplate = `
 <html>
 <head>
     <title>Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>Music Playlist</h1>
+    <form action="/create" method="post" class="grid-form">
+        <div class="form-group">
+            <label for="title">Track Title:</label>
+            <input type="text" id="title" name="title" value="{{with $.Form}}{{.Title}}{{end}}" required>
+            {{with index $.Errors "title"}}<span class="field-error">{{.}}</span>{{end}}
+        </div>
+        <div class="form-group">
+            <label for="artist">Artist:</label>
+            <input type="text" id="artist" name="artist" value="{{with $.Form}}{{.Artist}}{{end}}" required>
+            {{with index $.Errors "artist"}}<span class="field-error">{{.}}</span>{{end}}
+        </div>
+        <div class="form-group">
+            <label for="album">Album:</label>
+            <input type="text" id="album" name="album" value="{{with $.Form}}{{.Album}}{{end}}">
+            {{with index $.Errors "album"}}<span class="field-error">{{.}}</span>{{end}}
+        </div>
+        <div class="form-group">
+            <label for="duration">Duration:</label>
+            <input type="text" id="duration" name="duration" placeholder="3:45" value="{{with $.Form}}{{.Duration}}{{end}}" required>
+            {{with index $.Errors "duration"}}<span class="field-error">{{.}}</span>{{end}}
+        </div>
+		<div class="form-group">
+            <label for="link">Soundcloud Track Number or SoundCloud/YouTube URL:</label>
+            <input type="text" id="link" name="link" value="{{with $.Form}}{{.Link}}{{end}}" required>
+            {{with index $.Errors "link"}}<span class="field-error">{{.}}</span>{{end}}
+        </div>
+        <div class="form-group submit-group">
+            <input type="submit" value="Add Track">
+        </div>
+    </form>    
+    <hr>
+    <h2>Playlist</h2>
+    <form action="/playlist" method="get" class="search-form">
+        <input type="text" name="q" placeholder="Search title, artist or album" value="{{with .Search}}{{.Query}}{{end}}">
+        <input type="text" name="artist" placeholder="Artist" value="{{with .Search}}{{.Artist}}{{end}}">
+        <input type="text" name="album" placeholder="Album" value="{{with .Search}}{{.Album}}{{end}}">
+        <input type="submit" value="Search">
+    </form>
+    <span id="live-status" class="live-status">Connecting&hellip;</span>
+    <a href="/playlists" class="refresh-btn">Playlists</a>
+    <a href="/trash" class="refresh-btn">Trash</a>
+    <a href="/import" class="refresh-btn">Import</a>
+    <a href="{{.CSVURL}}" class="refresh-btn">Export CSV</a>
+    <a href="{{.JSONLURL}}" class="refresh-btn">Export JSON Lines</a>
+    <a href="{{.M3UURL}}" class="refresh-btn">Download M3U</a>
+    <a href="{{.XSPFURL}}" class="refresh-btn">Download XSPF</a>
+    <a href="/logout" class="back-btn">Sign Out</a>
+    <form action="/import" method="post" enctype="multipart/form-data" class="upload-form">
+        <input type="file" name="file" accept=".m3u,.m3u8,.xspf" required>
+        <input type="submit" value="Upload Playlist">
+    </form>
+    <div id="song-list">
+    <ul>
+        {{if not (eq (len .Songs) 0)}}
+            {{range .Songs}}
+            <li>
+				<span>{{.Title}} - {{.Artist}} - {{.Album}} - {{.Duration}}</span>
+				<div class="action-buttons">
+					{{if $.CanUpdate}}<a href="/update?id={{.Id}}">Update</a>{{end}}
+					<a href="/history?id={{.Id}}">History</a>
+					{{if $.CanDelete}}<form action="/delete" method="post" class="action-form">
+						<input type="hidden" name="id" value="{{.Id}}">
+						<input type="hidden" name="version" value="{{.Version}}">
+						<button type="submit" class="link-btn" style="color: #d32f2f;">Delete</button>
+					</form>{{end}}
+				</div>
+				<iframe width="100%" height="166" scrolling="no" frameborder="no" allow="autoplay"
+                    src="{{embedURL .Link}}">
+                </iframe>
+            </li>
+            {{end}}
+            {{else}}
+                <li>No songs available</li>
+            {{end}}
+    </ul>
+    <div class="pagination">
+        {{if .PrevURL}}<a href="{{.PrevURL}}" class="refresh-btn">&laquo; Previous</a>{{end}}
+        <span>{{.Total}} tracks</span>
+        {{if .NextURL}}<a href="{{.NextURL}}" class="refresh-btn">Next &raquo;</a>{{end}}
+    </div>
+    </div>
+</div>
+<script>
+    // Reload the song list whenever a song is created, updated or deleted.
+    var liveStatus = document.getElementById("live-status");
+    var events = new EventSource("/events");
+    var reloading = null;
+    function reloadSongs() {
+        // Imports publish many events at once, reload a single time for all of them.
+        if (reloading) return;
+        reloading = setTimeout(function () { reloading = null; loadSongs(); }, 300);
+    }
+    function loadSongs() {
+        fetch(location.href)
+            .then(function (res) { return res.text(); })
+            .then(function (html) {
+                var page = new DOMParser().parseFromString(html, "text/html");
+                document.getElementById("song-list").replaceWith(page.getElementById("song-list"));
+            });
+    }
+    ["created", "updated", "deleted", "reset"].forEach(function (type) {
+        events.addEventListener(type, reloadSongs);
+    });
+    events.onopen = function () { liveStatus.textContent = "Live"; };
+    events.onerror = function () { liveStatus.textContent = "Reconnecting\u2026"; };
+</script>
+</body>
+</html>`
+
+// styleTemplate defines the stylesheet shared by every HTML page.
+var styleTemplate = `{{define "style"}}
     <style>
 	body {
 		font-family: Arial, sans-serif;
@@ -308,7 +742,6 @@ var songsTemplate = `
 		background-color: #005f6b;
 	}
 	.update-form {
-		display: none;
 		margin-bottom: 10px;
 	}
 	.update-form input[type="submit"] {
@@ -348,85 +781,131 @@ var songsTemplate = `
 	.action-buttons {
 	float: right;
 	}
+	.action-form {
+		display: inline;
+		margin: 0;
+	}
+	.link-btn {
+		background: none;
+		border: none;
+		padding: 0;
+		font: inherit;
+		color: #4caf50;
+		cursor: pointer;
+	}
+	.link-btn:hover {
+		text-decoration: underline;
+	}
+	.field-error {
+		display: block;
+		margin: -5px 0 10px;
+		color: #ff6b6b;
+		font-size: 0.9em;
+	}
+	.search-form {
+		display: grid;
+		grid-template-columns: 3fr 2fr 2fr 1fr;
+		gap: 10px;
+	}
+	.error-message {
+		color: #ff6b6b;
+		margin-bottom: 20px;
+	}
+	.live-status {
+		display: inline-block;
+		margin-right: 10px;
+		color: #8bc34a;
+	}
+	.upload-form {
+		display: inline-flex;
+		gap: 10px;
+		align-items: center;
+	}
+	.import-failures {
+		width: 100%;
+		margin-bottom: 20px;
+		color: #fff;
+		text-align: left;
+	}
+	.pagination {
+		display: flex;
+		justify-content: space-between;
+		align-items: center;
+		color: #fff;
+	}
     </style>
+{{end}}`
+
+// updateTemplate defines the HTML template for the update form of a single song.
+var updateTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Update Track - Music Playlist</title>
+    {{template "style"}}
 </head>
 <body>
 <div class="container">
-    <h1>Music Playlist</h1>
-    <form action="/create" method="post" class="grid-form">
-        <div class="form-group">
-            <label for="title">Track Title:</label>
-            <input type="text" id="title" name="title" required>
-        </div>
-        <div class="form-group">
-            <label for="artist">Artist:</label>
-            <input type="text" id="artist" name="artist" required>
-        </div>
-        <div class="form-group">
-            <label for="album">Album:</label>
-            <input type="text" id="album" name="album" required>
-        </div>
-        <div class="form-group">
-            <label for="duration">Duration:</label>
-            <input type="text" id="duration" name="duration" required>
-        </div>
-		<div class="form-group">
-            <label for="link">Soundcloud Track Number: (embaded)</label>
-            <input type="text" id="link" name="link" required>
-        </div>
-        <div class="form-group submit-group">
-            <input type="submit" value="Add Track">
-        </div>
-    </form>    
-    <hr>
-    <h2>Playlist</h2>
-    <a href="/playlist" class="refresh-btn">Refresh Playlist</a>
-    <ul>
-        {{if not (eq (len .Songs) 0)}}
-            {{range .Songs}}
-            <li>
-				<span>{{.Title}} - {{.Artist}} - {{.Album}} - {{.Duration}}</span>
-				<div class="action-buttons">
-					<a href="#" onclick="showUpdateForm('{{.Id}}')">Update</a> 
-					<a style="color: #d32f2f;" href="/delete?id={{.Id}}">Delete</a>
-				</div>
-				<iframe width="100%" height="166" scrolling="no" frameborder="no" allow="autoplay"
-                    src="https://w.soundcloud.com/player/?url=https%3A//api.soundcloud.com/tracks/{{.Link}}&amp;color=%23ff5500&amp;auto_play=false&amp;hide_related=true&amp;show_comments=false&amp;show_user=false&amp;show_reposts=false&amp;show_teaser=true&amp;visual=true">
-                </iframe>
-                <form id="updateForm{{.Id}}" class="update-form" action="/update" method="post">
-					<input type="hidden" name="id" value="{{.Id}}">
-					<label for="title{{.Id}}">New Title:</label><br>
-					<input type="text" id="title{{.Id}}" name="title" value="{{.Title}}"><br>
-					<label for="artist{{.Id}}">New Artist:</label><br>
-					<input type="text" id="artist{{.Id}}" name="artist" value="{{.Artist}}"><br>
-                    <label for="album{{.Id}}">New Album:</label><br>
-					<input type="text" id="album{{.Id}}" name="album" value="{{.Album}}"><br>
-					<label for="duration{{.Id}}">New Duration:</label><br>
-					<input type="text" id="duration{{.Id}}" name="duration" value="{{.Duration}}"><br>
-					<label for="link{{.Id}}">New Link:</label><br>
-					<input type="text" id="link{{.Id}}" name="link" value="{{.Link}}"><br>
-					<input type="submit" value="Update Song">
-					<a href="/playlist" class="back-btn">Back</a>
-				</form>
-            </li>
-            {{end}}
-            {{else}}
-                <li>No songs available</li>
-            {{end}}
-    </ul>
+    <h1>Update Track</h1>
+    <form class="update-form" action="/update" method="post">
+        <input type="hidden" name="id" value="{{.Song.Id}}">
+        <input type="hidden" name="version" value="{{.Song.Version}}">
+        <label for="title">New Title:</label>
+        <input type="text" id="title" name="title" value="{{.Song.Title}}">
+        {{with index $.Errors "title"}}<span class="field-error">{{.}}</span>{{end}}
+        <label for="artist">New Artist:</label>
+        <input type="text" id="artist" name="artist" value="{{.Song.Artist}}">
+        {{with index $.Errors "artist"}}<span class="field-error">{{.}}</span>{{end}}
+        <label for="album">New Album:</label>
+        <input type="text" id="album" name="album" value="{{.Song.Album}}">
+        {{with index $.Errors "album"}}<span class="field-error">{{.}}</span>{{end}}
+        <label for="duration">New Duration:</label>
+        <input type="text" id="duration" name="duration" value="{{.Song.Duration}}">
+        {{with index $.Errors "duration"}}<span class="field-error">{{.}}</span>{{end}}
+        <label for="link">New Link:</label>
+        <input type="text" id="link" name="link" value="{{.Song.Link}}">
+        {{with index $.Errors "link"}}<span class="field-error">{{.}}</span>{{end}}
+        <a href="/playlist" class="back-btn">Back</a>
+        <input type="submit" value="Update Song">
+    </form>
 </div>
+</body>
+</html>`
 
-<script>
-    // Function to show the update track form
-    function showUpdateForm(trackId) {
-        var formId = 'updateForm' + trackId;
-        var form = document.getElementById(formId);
-        if (form.style.display === 'none') {
-            form.style.display = 'block';
-        } else {
-            form.style.display = 'none';
-        }
-    }
-</script>
+// errorTemplate defines the HTML template for the page shown when a request fails.
+var errorTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>{{.Code}} {{.Status}} - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>{{.Code}} {{.Status}}</h1>
+    <p class="error-message">{{.Action}}: {{.Message}}</p>
+    <a href="/playlist" class="back-btn">Back</a>
+</div>
+</body>
+</html>`
+
+// conflictTemplate defines the HTML template for the page shown when a song was changed by someone else.
+var conflictTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Conflict - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>This song was changed by someone else</h1>
+    <p class="error-message">
+        {{if .Updating}}Your changes were not saved{{else}}The song was not deleted{{end}},
+        because the song was changed after you opened it. Review the latest version and try again.
+    </p>
+    <a href="/playlist" class="back-btn">Back</a>
+    <a href="/update?id={{.ID}}" class="refresh-btn">Open latest version</a>
+</div>
 </body>
-</html>`
\ No newline at end of file
+</html>`
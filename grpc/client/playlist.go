@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// handlePlaylists handles requests to show all playlists and the contents of the selected one.
+func (s *httpServer) handlePlaylists(w http.ResponseWriter, r *http.Request) {
+	// Get selected playlist ID from URL parameter.
+	id := r.URL.Query().Get("id")
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Fetch list of playlists from server.
+	playlists, err := s.playlists.ListPlaylists(ctx, &musicplaylist.ListPlaylistsRequest{})
+	if err != nil {
+		renderError(w, "Failed to fetch playlists", err)
+		log.Printf("Failed to fetch playlists: %v\n", err)
+		return
+	}
+
+	// Prepare playlist data for display in HTML page.
+	type Track struct {
+		Position int
+		Song     *musicplaylist.Song
+	}
+	type ViewData struct {
+		Playlists []*musicplaylist.Playlist
+		Selected  *musicplaylist.Playlist
+		Tracks    []Track
+		Length    time.Duration
+		Songs     []*musicplaylist.Song
+	}
+	data := ViewData{
+		Playlists: playlists.List,
+	}
+
+	if id != "" {
+		// Fetch the selected playlist and its songs.
+		data.Selected, err = s.playlists.GetPlaylist(ctx, &wrapperspb.StringValue{Value: id})
+		if err != nil {
+			renderError(w, "Failed to fetch playlist", err)
+			return
+		}
+		for i, songID := range data.Selected.SongIds {
+			song, err := s.songs.GetSong(ctx, &wrapperspb.StringValue{Value: songID})
+			if status.Code(err) == codes.NotFound {
+				song = &musicplaylist.Song{Id: songID, Title: "(removed song)"}
+			} else if err != nil {
+				renderError(w, "Failed to fetch song", err)
+				return
+			}
+			data.Tracks = append(data.Tracks, Track{Position: i, Song: song})
+			data.Length += time.Duration(song.DurationMs) * time.Millisecond
+		}
+
+		// Fetch the songs that can be added to the playlist.
+		songs, err := s.songs.ListSongs(ctx, &musicplaylist.ListSongsRequest{PageSize: 100})
+		if err != nil {
+			renderError(w, "Failed to fetch songs", err)
+			return
+		}
+		data.Songs = songs.List
+	}
+
+	// Create HTML template.
+	tmpl := template.Must(template.New("playlists").Funcs(templateFuncs).Parse(playlistsTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+
+	// Display HTML template with prepared playlist data.
+	err = tmpl.Execute(w, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// handlePlaylistCreate handles requests to create a new playlist.
+func (s *httpServer) handlePlaylistCreate(w http.ResponseWriter, r *http.Request) {
+	s.playlistAction(w, r, func(ctx context.Context, c musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error) {
+		return c.CreatePlaylist(ctx, &musicplaylist.Playlist{
+			Name:        r.FormValue("name"),
+			Description: r.FormValue("description"),
+			Owner:       r.FormValue("owner"),
+		})
+	})
+}
+
+// handlePlaylistRename handles requests to rename an existing playlist.
+func (s *httpServer) handlePlaylistRename(w http.ResponseWriter, r *http.Request) {
+	s.playlistAction(w, r, func(ctx context.Context, c musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error) {
+		return c.RenamePlaylist(ctx, &musicplaylist.RenamePlaylistRequest{
+			Id:          r.FormValue("id"),
+			Name:        r.FormValue("name"),
+			Description: r.FormValue("description"),
+		})
+	})
+}
+
+// handlePlaylistDelete handles requests to delete an existing playlist.
+func (s *httpServer) handlePlaylistDelete(w http.ResponseWriter, r *http.Request) {
+	s.playlistAction(w, r, func(ctx context.Context, c musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error) {
+		_, err := c.DeletePlaylist(ctx, &wrapperspb.StringValue{Value: r.FormValue("id")})
+		return nil, err
+	})
+}
+
+// handlePlaylistAdd handles requests to add a song to a playlist.
+func (s *httpServer) handlePlaylistAdd(w http.ResponseWriter, r *http.Request) {
+	s.playlistAction(w, r, func(ctx context.Context, c musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error) {
+		return c.AddTrack(ctx, &musicplaylist.AddTrackRequest{
+			PlaylistId: r.FormValue("id"),
+			SongId:     r.FormValue("song_id"),
+		})
+	})
+}
+
+// handlePlaylistRemove handles requests to remove a track from a playlist.
+func (s *httpServer) handlePlaylistRemove(w http.ResponseWriter, r *http.Request) {
+	s.playlistAction(w, r, func(ctx context.Context, c musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error) {
+		position, _ := strconv.Atoi(r.FormValue("position"))
+		return c.RemoveTrack(ctx, &musicplaylist.RemoveTrackRequest{
+			PlaylistId: r.FormValue("id"),
+			Position:   int32(position),
+		})
+	})
+}
+
+// handlePlaylistMove handles requests to move a track within a playlist.
+func (s *httpServer) handlePlaylistMove(w http.ResponseWriter, r *http.Request) {
+	s.playlistAction(w, r, func(ctx context.Context, c musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error) {
+		from, _ := strconv.Atoi(r.FormValue("from"))
+		to, _ := strconv.Atoi(r.FormValue("to"))
+		return c.MoveTrack(ctx, &musicplaylist.MoveTrackRequest{
+			PlaylistId:   r.FormValue("id"),
+			FromPosition: int32(from),
+			ToPosition:   int32(to),
+		})
+	})
+}
+
+// playlistAction runs a single PlaylistApi call and redirects back to the playlists page.
+// The page shows the playlist returned by the call, if any. Only posted forms are accepted.
+func (s *httpServer) playlistAction(w http.ResponseWriter, r *http.Request, call func(context.Context, musicplaylist.PlaylistApiClient) (*musicplaylist.Playlist, error)) {
+	if !requirePost(w, r) {
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Run the call.
+	playlist, err := call(ctx, s.playlists)
+	if err != nil {
+		renderError(w, "Failed to update playlist", err)
+		return
+	}
+
+	// Redirect to playlists page.
+	target := "/playlists"
+	if playlist != nil {
+		target += "?" + url.Values{"id": {playlist.Id}}.Encode()
+	}
+	http.Redirect(w, r, target, http.StatusSeeOther)
+}
+
+// playlistsTemplate defines the HTML template for managing playlists.
+var playlistsTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Playlists - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>Playlists</h1>
+    <a href="/playlist" class="refresh-btn">All Tracks</a>
+    <ul>
+        {{range .Playlists}}
+        <li><a href="/playlists?id={{.Id}}">{{.Name}}</a> ({{len .SongIds}} tracks){{if .Owner}} by {{.Owner}}{{end}}</li>
+        {{else}}
+        <li>No playlists available</li>
+        {{end}}
+    </ul>
+    <form action="/playlists/create" method="post" class="grid-form">
+        <div class="form-group">
+            <label for="name">Playlist Name:</label>
+            <input type="text" id="name" name="name" required>
+        </div>
+        <div class="form-group">
+            <label for="owner">Owner:</label>
+            <input type="text" id="owner" name="owner">
+        </div>
+        <div class="form-group submit-group">
+            <label for="description">Description:</label>
+            <input type="text" id="description" name="description">
+        </div>
+        <div class="form-group submit-group">
+            <input type="submit" value="Create Playlist">
+        </div>
+    </form>
+    {{with .Selected}}
+    <hr>
+    <h2>{{.Name}} ({{len $.Tracks}} tracks, {{$.Length}})</h2>
+    <form action="/playlists/rename" method="post" class="grid-form">
+        <input type="hidden" name="id" value="{{.Id}}">
+        <div class="form-group">
+            <label for="rename">Name:</label>
+            <input type="text" id="rename" name="name" value="{{.Name}}" required>
+        </div>
+        <div class="form-group">
+            <label for="redescribe">Description:</label>
+            <input type="text" id="redescribe" name="description" value="{{.Description}}">
+        </div>
+        <div class="form-group submit-group">
+            <input type="submit" value="Rename Playlist">
+            <button type="submit" formaction="/playlists/delete" formnovalidate class="back-btn"
+                onclick="return confirm('Delete this playlist?')">Delete Playlist</button>
+            <a href="/playlists/export.m3u8?id={{.Id}}" class="back-btn">Download M3U</a>
+            <a href="/playlists/export.xspf?id={{.Id}}" class="back-btn">Download XSPF</a>
+        </div>
+    </form>
+    <form action="/playlists/add" method="post" class="grid-form">
+        <input type="hidden" name="id" value="{{.Id}}">
+        <div class="form-group">
+            <select name="song_id">
+                {{range $.Songs}}<option value="{{.Id}}">{{.Title}} - {{.Artist}}</option>{{end}}
+            </select>
+        </div>
+        <div class="form-group">
+            <input type="submit" value="Add Track">
+        </div>
+    </form>
+    <ul>
+        {{range $.Tracks}}
+        <li>
+            <span>{{add .Position 1}}. {{.Song.Title}} - {{.Song.Artist}} - {{.Song.Album}} - {{.Song.Duration}}</span>
+            <div class="action-buttons">
+                <form action="/playlists/move" method="post" class="action-form">
+                    <input type="hidden" name="id" value="{{$.Selected.Id}}">
+                    <input type="hidden" name="from" value="{{.Position}}">
+                    {{if gt .Position 0}}<button type="submit" name="to" value="{{add .Position -1}}" class="link-btn">Up</button>{{end}}
+                    {{if lt (add .Position 1) (len $.Tracks)}}<button type="submit" name="to" value="{{add .Position 1}}" class="link-btn">Down</button>{{end}}
+                </form>
+                <form action="/playlists/remove" method="post" class="action-form">
+                    <input type="hidden" name="id" value="{{$.Selected.Id}}">
+                    <input type="hidden" name="position" value="{{.Position}}">
+                    <button type="submit" class="link-btn" style="color: #d32f2f;">Remove</button>
+                </form>
+            </div>
+        </li>
+        {{else}}
+        <li>This playlist has no tracks</li>
+        {{end}}
+    </ul>
+    {{end}}
+</div>
+</body>
+</html>`
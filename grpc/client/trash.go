@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"html/template"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// handleTrash handles requests to list a page of deleted songs.
+// The page_token parameter selects the page.
+func (s *httpServer) handleTrash(w http.ResponseWriter, r *http.Request) {
+	// Get paging parameter from URL.
+	pageToken := r.URL.Query().Get("page_token")
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Fetch a page of deleted songs from server.
+	songs, err := s.songs.ListDeletedSongs(ctx, &musicplaylist.ListSongsRequest{
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		log.Printf("Failed to fetch deleted songs: %v\n", err)
+		renderError(w, "Failed to fetch deleted songs", err)
+		return
+	}
+
+	// Prepare song data for display in HTML page.
+	type ViewData struct {
+		Songs   []*musicplaylist.Song
+		NextURL string
+	}
+	data := ViewData{
+		Songs: songs.List,
+	}
+	if songs.NextPageToken != "" {
+		data.NextURL = "/trash?" + url.Values{"page_token": {songs.NextPageToken}}.Encode()
+	}
+
+	// Create HTML template.
+	tmpl := template.Must(template.New("trash").Parse(trashTemplate))
+	template.Must(tmpl.Parse(styleTemplate))
+
+	// Display HTML template with prepared song data.
+	err = tmpl.Execute(w, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// handleTrashRestore handles requests to move a song out of the trash.
+func (s *httpServer) handleTrashRestore(w http.ResponseWriter, r *http.Request) {
+	s.trashAction(w, r, "Failed to restore song", func(ctx context.Context, c musicplaylist.SongApiClient, id string) error {
+		_, err := c.RestoreSong(ctx, &wrapperspb.StringValue{Value: id})
+		return err
+	})
+}
+
+// handleTrashPurge handles requests to permanently delete a song from the trash.
+func (s *httpServer) handleTrashPurge(w http.ResponseWriter, r *http.Request) {
+	s.trashAction(w, r, "Failed to purge song", func(ctx context.Context, c musicplaylist.SongApiClient, id string) error {
+		_, err := c.PurgeSong(ctx, &wrapperspb.StringValue{Value: id})
+		return err
+	})
+}
+
+// trashAction runs a single SongApi call for the song given by the id parameter and redirects back to the trash page.
+// Only posted forms are accepted, the pages confirm a purge before posting it.
+func (s *httpServer) trashAction(w http.ResponseWriter, r *http.Request, action string, call func(context.Context, musicplaylist.SongApiClient, string) error) {
+	if !requirePost(w, r) {
+		return
+	}
+
+	// Bound the gRPC calls by the deadline of the request.
+	ctx, cancel := s.requestContext(r)
+	defer cancel()
+
+	// Run the call.
+	err := call(ctx, s.songs, r.FormValue("id"))
+	if err != nil {
+		renderError(w, action, err)
+		return
+	}
+
+	// Redirect to trash page.
+	http.Redirect(w, r, "/trash", http.StatusSeeOther)
+}
+
+// trashTemplate defines the HTML template for the list of deleted songs.
+var trashTemplate = `
+<!DOCTYPE html>
+<html>
+<head>
+    <title>Trash - Music Playlist</title>
+    {{template "style"}}
+</head>
+<body>
+<div class="container">
+    <h1>Trash</h1>
+    <p>Deleted tracks are kept here until they are restored or purged.</p>
+    <a href="/playlist" class="refresh-btn">All Tracks</a>
+    <ul>
+        {{range .Songs}}
+        <li>
+            <span>{{.Title}} - {{.Artist}} - {{.Album}} - {{.Duration}}</span>
+            {{with .DeletedAt}}<span>(deleted {{.AsTime.Format "2006-01-02 15:04"}})</span>{{end}}
+            <div class="action-buttons">
+                <form action="/trash/restore" method="post" class="action-form">
+                    <input type="hidden" name="id" value="{{.Id}}">
+                    <button type="submit" class="link-btn">Restore</button>
+                </form>
+                <form action="/trash/purge" method="post" class="action-form"
+                    onsubmit="return confirm('Delete this track forever? This cannot be undone.')">
+                    <input type="hidden" name="id" value="{{.Id}}">
+                    <button type="submit" class="link-btn" style="color: #d32f2f;">Delete Forever</button>
+                </form>
+            </div>
+        </li>
+        {{else}}
+        <li>The trash is empty</li>
+        {{end}}
+    </ul>
+    {{if .NextURL}}
+    <div class="pagination">
+        <span></span>
+        <a href="{{.NextURL}}" class="refresh-btn">Next &raquo;</a>
+    </div>
+    {{end}}
+</div>
+</body>
+</html>`
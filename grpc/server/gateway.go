@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// serveGateway serves the REST gateway of the song and playlist services on addr.
+// The gateway translates the google.api.http routes of musicplaylist.proto into calls to the gRPC server at grpcAddr,
+// so the calls go through the same interceptors as native gRPC clients.
+// The OpenAPI document of the routes is served at /openapi.json.
+func serveGateway(ctx context.Context, addr, grpcAddr string) error {
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// Forward the metadata understood by the services along with the default headers.
+	gateway := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(gatewayHeader))
+	err = musicplaylist.RegisterSongApiHandler(ctx, gateway, conn)
+	if err != nil {
+		return err
+	}
+	err = musicplaylist.RegisterPlaylistApiHandler(ctx, gateway, conn)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /openapi.json", handleOpenAPI)
+	mux.Handle("/", gateway)
+
+	log.Printf("Starting REST gateway on %s\n", addr)
+	return http.ListenAndServe(addr, mux)
+}
+
+// gatewayHeader returns the gRPC metadata key an HTTP header is forwarded as.
+// The x-dry-run header keeps its name, other headers follow the gateway defaults.
+func gatewayHeader(key string) (string, bool) {
+	switch strings.ToLower(key) {
+	case "x-dry-run":
+		return strings.ToLower(key), true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// handleOpenAPI serves the OpenAPI document of the REST gateway.
+func handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(musicplaylist.OpenAPI)
+}
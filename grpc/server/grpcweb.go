@@ -0,0 +1,397 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
+	"github.com/spf13/viper"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxGRPCWebMessageSize is the largest message of a gRPC-Web request, the default limit of the gRPC server.
+const maxGRPCWebMessageSize = 4 << 20
+
+// grpcWebTrailerFlag marks the frame of a gRPC-Web response carrying the trailers of the call.
+const grpcWebTrailerFlag = 0x80
+
+// grpcWebHeaders are the request headers forwarded to the gRPC server as metadata.
+var grpcWebHeaders = []string{"authorization", "x-dry-run"}
+
+// serveGRPC serves the gRPC server on listener to native gRPC clients and to browsers.
+// Requests are told apart by their content type: HTTP/2 requests of type application/grpc go to the gRPC server,
+// every other request, such as gRPC-Web calls over HTTP/1.1 and their CORS preflights, goes to the gRPC-Web handler.
+// HTTP/2 is accepted without TLS, as native gRPC clients connect in plain text,
+// and the keepalive pings of the clients are answered at any rate.
+// The gRPC-Web handler calls the gRPC server back at grpcAddr, so browser calls go through the same interceptors.
+func serveGRPC(server *grpc.Server, listener net.Listener, grpcAddr string) error {
+	conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	web := http.NewServeMux()
+	registerGRPCWeb(web, conn)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ProtoMajor == 2 && nativeGRPC(r.Header.Get("Content-Type")) {
+			server.ServeHTTP(w, r)
+			return
+		}
+		web.ServeHTTP(w, r)
+	})
+	return (&http.Server{Handler: h2c.NewHandler(handler, &http2.Server{})}).Serve(listener)
+}
+
+// nativeGRPC returns whether contentType is the content type of native gRPC requests, application/grpc or application/grpc+proto.
+func nativeGRPC(contentType string) bool {
+	return contentType == "application/grpc" || strings.HasPrefix(contentType, "application/grpc+") ||
+		strings.HasPrefix(contentType, "application/grpc;")
+}
+
+// registerGRPCWeb registers the gRPC-Web handler of the song and playlist services on mux.
+// Browsers call the RPCs at their gRPC paths, such as /protoapi.SongApi/ListSongs,
+// and the calls are forwarded to the gRPC server through conn, so they go through the same interceptors as native gRPC clients.
+func registerGRPCWeb(mux *http.ServeMux, conn *grpc.ClientConn) {
+	handler := newGRPCWebHandler(conn)
+	mux.Handle("/"+musicplaylist.SongApi_ServiceDesc.ServiceName+"/", handler)
+	mux.Handle("/"+musicplaylist.PlaylistApi_ServiceDesc.ServiceName+"/", handler)
+}
+
+// grpcWebHandler translates gRPC-Web requests into calls to the gRPC server.
+// Both the binary and the base64 text encodings of the protocol are served, over HTTP/1.1 or HTTP/2.
+type grpcWebHandler struct {
+	conn    *grpc.ClientConn
+	origins []string // Origins browsers are allowed to call from, "*" allows every origin
+}
+
+// newGRPCWebHandler creates the gRPC-Web handler forwarding calls through conn.
+// Browsers are allowed to call from the origins listed in app.grpc.web.allowed_origins.
+func newGRPCWebHandler(conn *grpc.ClientConn) *grpcWebHandler {
+	return &grpcWebHandler{conn: conn, origins: viper.GetStringSlice("app.grpc.web.allowed_origins")}
+}
+
+// allowed returns whether browsers may call from origin.
+func (h *grpcWebHandler) allowed(origin string) bool {
+	for _, o := range h.origins {
+		if o == "*" || o == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// ServeHTTP answers CORS preflight requests and forwards gRPC-Web calls to the gRPC server.
+// The messages of client streaming calls such as ImportSongs are forwarded as they are read from the request body,
+// server streaming calls such as WatchSongs write every message as soon as it is received.
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if !h.allowed(origin) {
+			http.Error(w, "origin not allowed", http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+		w.Header().Set("Access-Control-Expose-Headers", "grpc-status, grpc-message, grpc-status-details-bin")
+	}
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", "POST")
+		w.Header().Set("Access-Control-Allow-Headers",
+			"authorization, content-type, grpc-timeout, x-dry-run, x-grpc-web, x-user-agent")
+		w.Header().Set("Access-Control-Max-Age", "600")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST, OPTIONS")
+		http.Error(w, "gRPC-Web calls must be POST requests", http.StatusMethodNotAllowed)
+		return
+	}
+	text, ok := grpcWebEncoding(r.Header.Get("Content-Type"))
+	if !ok {
+		http.Error(w, "content type must be application/grpc-web or application/grpc-web-text", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	if text {
+		w.Header().Set("Content-Type", "application/grpc-web-text+proto")
+	} else {
+		w.Header().Set("Content-Type", "application/grpc-web+proto")
+	}
+	out := &grpcWebWriter{w: w, text: text}
+	err := h.call(r, out)
+	out.writeTrailers(err)
+}
+
+// call forwards the gRPC-Web request to the gRPC server and writes the header and messages of the response to out.
+// The messages of the request are sent one at a time as they are read, so only one of them is held in memory.
+// It returns the status of the call.
+func (h *grpcWebHandler) call(r *http.Request, out *grpcWebWriter) error {
+	var body io.Reader = r.Body
+	if out.text {
+		body = base64.NewDecoder(base64.StdEncoding, body)
+	}
+	body = bufio.NewReader(body)
+
+	ctx, cancel, err := grpcWebContext(r)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	// Every RPC is called as a bidirectional stream of raw messages, which suits unary and streaming RPCs alike.
+	stream, err := h.conn.NewStream(ctx, &grpc.StreamDesc{ClientStreams: true, ServerStreams: true}, r.URL.Path,
+		grpc.ForceCodec(rawCodec{}))
+	if err != nil {
+		return err
+	}
+	for {
+		message, err := readGRPCWebMessage(body)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			// Returning cancels the call, so the server does not take the messages sent so far as the whole request.
+			return err
+		}
+		err = stream.SendMsg(&message)
+		if errors.Is(err, io.EOF) {
+			// The server ended the call, its status is returned by RecvMsg.
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	err = stream.CloseSend()
+	if err != nil {
+		return err
+	}
+
+	header, _ := stream.Header()
+	out.writeHeader(header)
+	for {
+		var message []byte
+		err = stream.RecvMsg(&message)
+		if errors.Is(err, io.EOF) {
+			out.trailer = stream.Trailer()
+			return nil
+		}
+		if err != nil {
+			out.trailer = stream.Trailer()
+			return err
+		}
+		err = out.writeFrame(0, message)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// grpcWebEncoding returns whether contentType is the text encoding of gRPC-Web, where frames are base64 encoded.
+// It returns false as second value when contentType is not a gRPC-Web content type with protobuf messages.
+func grpcWebEncoding(contentType string) (text bool, ok bool) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false, false
+	}
+	switch mediaType {
+	case "application/grpc-web", "application/grpc-web+proto":
+		return false, true
+	case "application/grpc-web-text", "application/grpc-web-text+proto":
+		return true, true
+	}
+	return false, false
+}
+
+// grpcWebContext returns the context of the call forwarded for r.
+// It carries the headers listed in grpcWebHeaders as outgoing metadata and the deadline set by the grpc-timeout header.
+func grpcWebContext(r *http.Request) (context.Context, context.CancelFunc, error) {
+	md := metadata.MD{}
+	for _, key := range grpcWebHeaders {
+		if values := r.Header.Values(key); len(values) > 0 {
+			md.Set(key, values...)
+		}
+	}
+	ctx := metadata.NewOutgoingContext(r.Context(), md)
+
+	timeout := r.Header.Get("grpc-timeout")
+	if timeout == "" {
+		ctx, cancel := context.WithCancel(ctx)
+		return ctx, cancel, nil
+	}
+	d, err := parseGRPCTimeout(timeout)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid grpc-timeout %q: %v", timeout, err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, d)
+	return ctx, cancel, nil
+}
+
+// parseGRPCTimeout parses the value of a grpc-timeout header, an integer followed by its unit such as "500m" or "10S".
+func parseGRPCTimeout(timeout string) (time.Duration, error) {
+	if len(timeout) < 2 {
+		return 0, errors.New("missing unit")
+	}
+	units := map[byte]time.Duration{
+		'H': time.Hour, 'M': time.Minute, 'S': time.Second,
+		'm': time.Millisecond, 'u': time.Microsecond, 'n': time.Nanosecond,
+	}
+	unit, ok := units[timeout[len(timeout)-1]]
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q", timeout[len(timeout)-1])
+	}
+	value, err := strconv.ParseInt(timeout[:len(timeout)-1], 10, 64)
+	if err != nil || value < 0 || len(timeout) > 9 {
+		return 0, errors.New("value must be a positive integer of at most 8 digits")
+	}
+	return time.Duration(value) * unit, nil
+}
+
+// readGRPCWebMessage reads the next length-prefixed message of a gRPC-Web request body.
+// It returns io.EOF when the body ends before a message.
+// Compressed messages are not supported since the handler does not offer any compression.
+func readGRPCWebMessage(r io.Reader) ([]byte, error) {
+	var prefix [5]byte
+	_, err := io.ReadFull(r, prefix[:])
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed request body: %v", err)
+	}
+	if prefix[0] != 0 {
+		return nil, status.Errorf(codes.Unimplemented, "unsupported message flags %#x", prefix[0])
+	}
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxGRPCWebMessageSize {
+		return nil, status.Errorf(codes.ResourceExhausted, "message of %d bytes is larger than %d bytes", size, maxGRPCWebMessageSize)
+	}
+	message := make([]byte, size)
+	_, err = io.ReadFull(r, message)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed request body: %v", err)
+	}
+	return message, nil
+}
+
+// grpcWebWriter writes the frames of a gRPC-Web response.
+// The status and trailers of the call are always sent in the last frame of the body, so browsers read them
+// the same way whether the call failed before or after the first message.
+type grpcWebWriter struct {
+	w       http.ResponseWriter
+	text    bool        // Whether frames are base64 encoded
+	trailer metadata.MD // Trailers of the call, sent with its status
+}
+
+// writeHeader adds the header metadata of the call to the response headers.
+func (o *grpcWebWriter) writeHeader(md metadata.MD) {
+	for key, values := range md {
+		if strings.HasPrefix(key, "grpc-") || key == "content-type" {
+			continue
+		}
+		for _, value := range values {
+			o.w.Header().Add(key, metadataValue(key, value))
+		}
+	}
+}
+
+// writeFrame writes a frame with flag and payload and flushes it to the browser.
+func (o *grpcWebWriter) writeFrame(flag byte, payload []byte) error {
+	frame := make([]byte, 5+len(payload))
+	frame[0] = flag
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	copy(frame[5:], payload)
+	if o.text {
+		frame = []byte(base64.StdEncoding.EncodeToString(frame))
+	}
+	_, err := o.w.Write(frame)
+	if err != nil {
+		return err
+	}
+	return http.NewResponseController(o.w).Flush()
+}
+
+// writeTrailers writes the trailer frame carrying the status of the call given by err and its trailer metadata.
+func (o *grpcWebWriter) writeTrailers(err error) {
+	st := status.Convert(err)
+	var trailers strings.Builder
+	fmt.Fprintf(&trailers, "grpc-status: %d\r\n", st.Code())
+	if st.Message() != "" {
+		fmt.Fprintf(&trailers, "grpc-message: %s\r\n", encodeGRPCMessage(st.Message()))
+	}
+	if len(st.Details()) > 0 {
+		details, err := proto.Marshal(st.Proto())
+		if err == nil {
+			fmt.Fprintf(&trailers, "grpc-status-details-bin: %s\r\n", base64.RawStdEncoding.EncodeToString(details))
+		}
+	}
+	for key, values := range o.trailer {
+		if strings.HasPrefix(key, "grpc-") || key == "content-type" {
+			continue
+		}
+		for _, value := range values {
+			fmt.Fprintf(&trailers, "%s: %s\r\n", key, metadataValue(key, value))
+		}
+	}
+	o.writeFrame(grpcWebTrailerFlag, []byte(trailers.String()))
+}
+
+// metadataValue returns value of the metadata key as sent in HTTP, binary values are base64 encoded.
+func metadataValue(key, value string) string {
+	if strings.HasSuffix(key, "-bin") {
+		return base64.RawStdEncoding.EncodeToString([]byte(value))
+	}
+	return value
+}
+
+// encodeGRPCMessage percent-encodes the status message the way the gRPC protocol sends it in grpc-message.
+func encodeGRPCMessage(message string) string {
+	var b strings.Builder
+	for i := 0; i < len(message); i++ {
+		c := message[i]
+		if c < ' ' || c > '~' || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// rawCodec passes the messages of a call through unchanged, the gRPC-Web handler has no need to decode them.
+type rawCodec struct{}
+
+// Marshal returns the bytes held by v, a *[]byte.
+func (rawCodec) Marshal(v interface{}) ([]byte, error) {
+	return *v.(*[]byte), nil
+}
+
+// Unmarshal stores a copy of data in v, a *[]byte.
+func (rawCodec) Unmarshal(data []byte, v interface{}) error {
+	*v.(*[]byte) = append([]byte(nil), data...)
+	return nil
+}
+
+// Name returns the name of the codec, "proto" so the server decodes the messages as protobuf.
+func (rawCodec) Name() string {
+	return "proto"
+}
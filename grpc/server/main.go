@@ -8,9 +8,11 @@ import (
 	"os"
 	"time"
 
+	"github.com/Dwiyasa-Nakula/master/backend/authz"
 	"github.com/Dwiyasa-Nakula/master/backend/genproto/musicplaylist"
 	"github.com/Dwiyasa-Nakula/master/backend/repository"
 	"github.com/Dwiyasa-Nakula/master/backend/service"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/spf13/viper"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -33,12 +35,161 @@ func init() {
 	if err != nil {
 		panic(fmt.Errorf("fatal error config file: %s.yml", err))
 	}
+
+	// Secrets are read from the environment rather than the config files.
+	viper.BindEnv("app.auth.jwt.hmac_secret", "MUSICPLAYLIST_JWT_SECRET")
 }
 
 func main() {
 	// Log the start of the GRPC server.
 	log.Println("Starting up GRPC server")
 
+	// Initialize repositories for the configured storage driver.
+	songs, playlists, audits := newStores()
+
+	// Run the one-shot duration migration instead of serving when asked to.
+	if len(os.Args) > 2 && os.Args[2] == "migrate-durations" {
+		migrated, skipped, err := repository.MigrateDurations(songs)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		log.Printf("Migrated %d songs, skipped %d songs with invalid durations\n", migrated, skipped)
+		return
+	}
+
+	// Purge songs that stayed in the trash longer than the retention period.
+	if retention := viper.GetDuration("app.trash.retention"); retention > 0 {
+		interval := viper.GetDuration("app.trash.purge_interval")
+		if interval <= 0 {
+			interval = time.Hour
+		}
+		go service.PurgeTrash(context.Background(), songs, audits, retention, interval)
+	}
+
+	// Create new GRPC server.
+	// Every call must carry a bearer token accepted by the authenticator,
+	// and every call must be allowed to the roles of the caller by the policy.
+	auth := newAuthenticator()
+	authorizer := service.NewAuthorizer(newPolicy())
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(auth.UnaryInterceptor(), authorizer.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(auth.StreamInterceptor(), authorizer.StreamInterceptor()),
+	)
+
+	// Initialize services.
+	events := repository.NewSongEventBus()
+	usvc := service.NewSongService(songs, audits, events, newSongWatcher(songs, events))
+	musicplaylist.RegisterSongApiServer(server, usvc)
+	psvc := service.NewPlaylistService(playlists, songs)
+	musicplaylist.RegisterPlaylistApiServer(server, psvc)
+
+	// Get port from configuration.
+	port := ":" + viper.GetString("app.grpc.port")
+
+	// Listen on the specified port.
+	listener, err := net.Listen("tcp", port)
+	if err != nil {
+		log.Fatalf("could not listen to %s: %v", port, err)
+	}
+
+	// Serve the REST gateway when a port is configured for it.
+	if gatewayPort := viper.GetString("app.gateway.port"); gatewayPort != "" {
+		go func() {
+			grpcAddr := net.JoinHostPort("localhost", viper.GetString("app.grpc.port"))
+			err := serveGateway(context.Background(), ":"+gatewayPort, grpcAddr)
+			log.Fatalf("could not serve REST gateway: %v", err)
+		}()
+	}
+
+	// Start the server for gRPC and gRPC-Web clients and panic if there's an error.
+	grpcAddr := net.JoinHostPort("localhost", viper.GetString("app.grpc.port"))
+	panic(serveGRPC(server, listener, grpcAddr))
+}
+
+// newSongWatcher returns the source of the changes streamed by WatchSongs.
+// MongoDB change streams see the changes made by every server and are used when the database supports them,
+// otherwise the changes are read from the in-process event bus.
+func newSongWatcher(songs repository.SongStore, events *repository.SongEventBus) repository.SongWatcher {
+	if repo, ok := songs.(*repository.SongRepository); ok && repo.SupportsChangeStreams() {
+		log.Println("Watching songs with MongoDB change streams")
+		return repo
+	}
+	log.Println("Watching songs with the in-process event bus")
+	return events
+}
+
+// newAuthenticator creates the authenticator of the gRPC calls from the app.auth settings.
+// JWTs are verified with the secret at app.auth.jwt.hmac_secret or the PEM public key in the file at
+// app.auth.jwt.rsa_public_key, and the static keys listed in app.auth.api_keys are accepted as well.
+// The key of an API key left empty in the config is read from the MUSICPLAYLIST_API_KEY_<SUBJECT> environment variable,
+// API keys without a key are skipped.
+func newAuthenticator() *service.Authenticator {
+	config := service.AuthConfig{
+		HMACSecret: []byte(viper.GetString("app.auth.jwt.hmac_secret")),
+		Issuer:     viper.GetString("app.auth.jwt.issuer"),
+		Audience:   viper.GetString("app.auth.jwt.audience"),
+	}
+	if path := viper.GetString("app.auth.jwt.rsa_public_key"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("could not read RSA public key: %v", err)
+		}
+		config.RSAPublicKey, err = jwt.ParseRSAPublicKeyFromPEM(data)
+		if err != nil {
+			log.Fatalf("could not parse RSA public key: %v", err)
+		}
+	}
+	var keys []service.APIKey
+	err := viper.UnmarshalKey("app.auth.api_keys", &keys)
+	if err != nil {
+		log.Fatalf("could not read API keys: %v", err)
+	}
+	for _, key := range keys {
+		if key.Key == "" {
+			key.Key = authz.SecretFromEnv("MUSICPLAYLIST_API_KEY_", key.Subject)
+		}
+		if key.Key == "" {
+			log.Printf("Skipping API key of %s: no key configured \n", key.Subject)
+			continue
+		}
+		config.APIKeys = append(config.APIKeys, key)
+	}
+
+	auth, err := service.NewAuthenticator(config)
+	if err != nil {
+		log.Fatalf("could not configure authentication: %v", err)
+	}
+	return auth
+}
+
+// newPolicy creates the authorization policy of the gRPC services.
+// It is the default policy, with the rules listed in app.authz.policy replacing the roles of the RPCs they name.
+func newPolicy() authz.Policy {
+	var rules []authz.Rule
+	err := viper.UnmarshalKey("app.authz.policy", &rules)
+	if err != nil {
+		log.Fatalf("could not read authorization policy: %v", err)
+	}
+	return authz.DefaultPolicy().Override(rules)
+}
+
+// newStores creates the song, playlist and audit repositories for the storage driver set in app.storage.driver.
+// The "memory" driver keeps everything in process, the "sqlite" driver uses the file at app.sqlite.path
+// and any other value connects to MongoDB.
+func newStores() (repository.SongStore, repository.PlaylistStore, repository.AuditStore) {
+	driver := viper.GetString("app.storage.driver")
+	log.Printf("Using %s storage driver\n", driver)
+	switch driver {
+	case "memory":
+		return repository.NewMemorySongRepo(), repository.NewMemoryPlaylistRepo(), repository.NewMemoryAuditRepo()
+	case "sqlite":
+		db, err := repository.OpenSQLite(viper.GetString("app.sqlite.path"))
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		return repository.NewSQLiteSongRepo(db), repository.NewSQLitePlaylistRepo(db), repository.NewSQLiteAuditRepo(db)
+	}
+
 	// Create connection to database.
 	log.Println("Creating connection to database")
 	client, err := mongo.NewClient(options.Client().ApplyURI(viper.GetString("app.mongodb.uri")))
@@ -58,23 +209,22 @@ func main() {
 	}
 	log.Println("Connected to database...")
 
-	// Create new GRPC server.
-	server := grpc.NewServer()
-
-	// Initialize repository and service.
 	urepo := repository.NewSongRepo(db)
-	usvc := service.NewSongService(urepo)
-	musicplaylist.RegisterSongApiServer(server, usvc)
-
-	// Get port from configuration.
-	port := ":" + viper.GetString("app.grpc.port")
-
-	// Listen on the specified port.
-	listener, err := net.Listen("tcp", port)
+	err = urepo.EnsureIndexes()
 	if err != nil {
-		log.Fatalf("could not listen to %s: %v", port, err)
+		log.Fatalf("%v", err)
 	}
-
-	// Start the server and panic if there's an error.
-	panic(server.Serve(listener))
+	versioned, err := urepo.EnsureVersions()
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if versioned > 0 {
+		log.Printf("Set version 1 on %d songs \n", versioned)
+	}
+	arepo := repository.NewAuditRepo(db)
+	err = arepo.EnsureIndexes()
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	return urepo, repository.NewPlaylistRepo(db), arepo
 }
\ No newline at end of file